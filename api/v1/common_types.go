@@ -148,3 +148,14 @@ type SecretRef struct {
 	// +kubebuilder:validation:Required
 	Key string `json:"key"`
 }
+
+// ConfigMapRef references a key of a Kubernetes ConfigMap
+type ConfigMapRef struct {
+	// Name is the name of the ConfigMap
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Key is the key within the ConfigMap
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+}
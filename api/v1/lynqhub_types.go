@@ -18,17 +18,33 @@ package v1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // SourceType defines the type of external data source
-// +kubebuilder:validation:Enum=mysql
+// +kubebuilder:validation:Enum=mysql;postgresql;http;kubernetes;plugin
 type SourceType string
 
 const (
-	SourceTypeMySQL SourceType = "mysql"
+	SourceTypeMySQL      SourceType = "mysql"
+	SourceTypePostgreSQL SourceType = "postgresql"
+	SourceTypeHTTP       SourceType = "http"
+	SourceTypeKubernetes SourceType = "kubernetes"
+	SourceTypePlugin     SourceType = "plugin"
+)
+
+// PostgreSQLSSLMode defines the libpq sslmode used for PostgreSQL connections
+// +kubebuilder:validation:Enum=disable;require;verify-ca;verify-full
+type PostgreSQLSSLMode string
+
+const (
+	PostgreSQLSSLModeDisable    PostgreSQLSSLMode = "disable"
+	PostgreSQLSSLModeRequire    PostgreSQLSSLMode = "require"
+	PostgreSQLSSLModeVerifyCA   PostgreSQLSSLMode = "verify-ca"
+	PostgreSQLSSLModeVerifyFull PostgreSQLSSLMode = "verify-full"
 )
 
 // MySQLSource defines MySQL connection parameters
@@ -57,8 +73,313 @@ type MySQLSource struct {
 	Database string `json:"database"`
 
 	// Table is the MySQL table name containing node data
+	// Exactly one of table or query must be set
+	// +optional
+	Table string `json:"table,omitempty"`
+
+	// Query is a read-only, parameterless SELECT used instead of table
+	// Its result columns are mapped through valueMappings and extraValueMappings
+	// Exactly one of table or query must be set
+	// +optional
+	Query string `json:"query,omitempty"`
+
+	// TLS enables encrypted connections, optionally with a client certificate (mTLS)
+	// +optional
+	TLS *MySQLTLS `json:"tls,omitempty"`
+
+	// Replicas are fallback endpoints (e.g. read replicas) tried in order when host is unavailable
+	// They use the credentials, database and TLS settings of the source. A failed endpoint is skipped
+	// with an exponential backoff, and syncs return to host once it answers again.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	Replicas []MySQLEndpoint `json:"replicas,omitempty"`
+}
+
+// MySQLEndpoint is a MySQL server address
+type MySQLEndpoint struct {
+	// Host is the MySQL server hostname or IP
 	// +kubebuilder:validation:Required
-	Table string `json:"table"`
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Port is the MySQL server port
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=3306
+	// +optional
+	Port int32 `json:"port,omitempty"`
+}
+
+// MySQLTLS defines TLS settings for MySQL connections
+type MySQLTLS struct {
+	// CARef references a Secret key containing the PEM CA bundle used to verify the server
+	// If not set, the system root CAs are used
+	// +optional
+	CARef *SecretRef `json:"caRef,omitempty"`
+
+	// ClientCertRef references a Secret key containing the PEM client certificate for mTLS
+	// Must be set together with clientKeyRef
+	// +optional
+	ClientCertRef *SecretRef `json:"clientCertRef,omitempty"`
+
+	// ClientKeyRef references a Secret key containing the PEM client private key for mTLS
+	// Must be set together with clientCertRef
+	// +optional
+	ClientKeyRef *SecretRef `json:"clientKeyRef,omitempty"`
+
+	// ServerName overrides the host name used to verify the server certificate
+	// Defaults to host
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// InsecureSkipVerify disables server certificate verification (not recommended)
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// PostgreSQLSource defines PostgreSQL connection parameters
+type PostgreSQLSource struct {
+	// Host is the PostgreSQL server hostname or IP
+	// +kubebuilder:validation:Required
+	Host string `json:"host"`
+
+	// Port is the PostgreSQL server port
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=5432
+	Port int32 `json:"port"`
+
+	// Username is the PostgreSQL username
+	// +kubebuilder:validation:Required
+	Username string `json:"username"`
+
+	// PasswordRef references a Secret containing the PostgreSQL password
+	// +optional
+	PasswordRef *SecretRef `json:"passwordRef,omitempty"`
+
+	// Database is the PostgreSQL database name
+	// +kubebuilder:validation:Required
+	Database string `json:"database"`
+
+	// Schema is the schema containing the table
+	// If empty, the table is resolved through the server search_path
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// Table is the PostgreSQL table name containing node data
+	// Exactly one of table or query must be set
+	// +optional
+	Table string `json:"table,omitempty"`
+
+	// Query is a read-only, parameterless SELECT used instead of schema and table
+	// Its result columns are mapped through valueMappings and extraValueMappings
+	// Exactly one of table or query must be set
+	// +optional
+	Query string `json:"query,omitempty"`
+
+	// SSLMode is the libpq sslmode used for the connection
+	// +optional
+	// +kubebuilder:default=require
+	SSLMode PostgreSQLSSLMode `json:"sslMode,omitempty"`
+}
+
+// HTTPSource defines a JSON REST API endpoint returning node rows.
+// valueMappings and extraValueMappings name fields of each row object; a mapping
+// starting with "$" is a JSONPath evaluated against the row (e.g. $.owner.email).
+type HTTPSource struct {
+	// URL is the endpoint queried with GET
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// Headers are additional request headers
+	// Credentials belong in auth, not here
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Auth authenticates requests with a bearer token or basic auth
+	// +optional
+	Auth *HTTPAuth `json:"auth,omitempty"`
+
+	// RowsPath is a JSONPath selecting the row array in the response, e.g. $.data.items
+	// Defaults to $ (the response is the array)
+	// +kubebuilder:default="$"
+	// +optional
+	RowsPath string `json:"rowsPath,omitempty"`
+
+	// Pagination follows next links or cursors across pages
+	// If not set, only the first page is read
+	// +optional
+	Pagination *HTTPPagination `json:"pagination,omitempty"`
+}
+
+// HTTPAuthType defines how HTTP requests are authenticated
+// +kubebuilder:validation:Enum=bearer;basic
+type HTTPAuthType string
+
+const (
+	// HTTPAuthTypeBearer sends "Authorization: Bearer <token>"
+	HTTPAuthTypeBearer HTTPAuthType = "bearer"
+	// HTTPAuthTypeBasic sends HTTP basic auth with username and password
+	HTTPAuthTypeBasic HTTPAuthType = "basic"
+)
+
+// HTTPAuth defines the credentials of an HTTP source
+type HTTPAuth struct {
+	// Type is the authentication scheme
+	// +kubebuilder:validation:Required
+	Type HTTPAuthType `json:"type"`
+
+	// Username is the basic auth username (basic only)
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// SecretRef references a Secret key containing the bearer token or the basic auth password
+	// +kubebuilder:validation:Required
+	SecretRef SecretRef `json:"secretRef"`
+}
+
+// HTTPPaginationType defines how the next page is found
+// +kubebuilder:validation:Enum=nextLink;cursor
+type HTTPPaginationType string
+
+const (
+	// HTTPPaginationNextLink follows a next page URL from the body or the Link header
+	HTTPPaginationNextLink HTTPPaginationType = "nextLink"
+	// HTTPPaginationCursor sends a cursor from the body as a query parameter
+	HTTPPaginationCursor HTTPPaginationType = "cursor"
+)
+
+// HTTPPagination defines how an HTTP source is paged
+type HTTPPagination struct {
+	// Type is the pagination style
+	// +kubebuilder:validation:Required
+	Type HTTPPaginationType `json:"type"`
+
+	// NextLinkPath is a JSONPath to the next page URL in the body (nextLink only)
+	// If empty, the Link header with rel="next" is used
+	// +optional
+	NextLinkPath string `json:"nextLinkPath,omitempty"`
+
+	// CursorPath is a JSONPath to the next cursor in the body (cursor only)
+	// +optional
+	CursorPath string `json:"cursorPath,omitempty"`
+
+	// CursorParam is the query parameter the cursor is sent in (cursor only)
+	// +optional
+	CursorParam string `json:"cursorParam,omitempty"`
+
+	// MaxPages fails the sync when the endpoint returns more pages
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1000
+	// +optional
+	MaxPages int32 `json:"maxPages,omitempty"`
+}
+
+// KubernetesRowFormat defines how rows stored in a ConfigMap or Secret are encoded
+// +kubebuilder:validation:Enum=yaml;json;csv
+type KubernetesRowFormat string
+
+const (
+	// KubernetesRowFormatYAML is a YAML list of row objects
+	KubernetesRowFormatYAML KubernetesRowFormat = "yaml"
+	// KubernetesRowFormatJSON is a JSON array of row objects
+	KubernetesRowFormatJSON KubernetesRowFormat = "json"
+	// KubernetesRowFormatCSV is a CSV table whose first line names the columns
+	KubernetesRowFormatCSV KubernetesRowFormat = "csv"
+)
+
+// KubernetesSource defines node rows stored in the cluster, in a ConfigMap or Secret key
+// or inline in the hub. valueMappings and extraValueMappings name fields of each row;
+// a mapping starting with "$" is a JSONPath evaluated against the row.
+// Exactly one of configMapRef, secretRef or rows must be set.
+// Edits of the referenced ConfigMap or Secret trigger a sync immediately.
+type KubernetesSource struct {
+	// ConfigMapRef references a ConfigMap key holding the rows
+	// +optional
+	ConfigMapRef *ConfigMapRef `json:"configMapRef,omitempty"`
+
+	// SecretRef references a Secret key holding the rows
+	// +optional
+	SecretRef *SecretRef `json:"secretRef,omitempty"`
+
+	// Format is the encoding of the ConfigMap or Secret payload
+	// +kubebuilder:default=yaml
+	// +optional
+	Format KubernetesRowFormat `json:"format,omitempty"`
+
+	// Rows lists the rows inline, one object per row
+	// +optional
+	Rows []runtime.RawExtension `json:"rows,omitempty"`
+}
+
+// PluginSource defines an out-of-process datasource reached over gRPC.
+// The endpoint implements the lynq.datasource.v1.Datasource QueryNodes contract,
+// so proprietary backends can be added without patching the operator.
+type PluginSource struct {
+	// Endpoint is the gRPC target, e.g. localhost:9000 for a sidecar
+	// or dns:///lynq-plugin.tools.svc.cluster.local:9000 for an in-cluster Service
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+
+	// Options are passed to the plugin with every request (e.g. the table or collection to read)
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+
+	// TokenRef references a Secret key whose value is sent as a bearer token
+	// +optional
+	TokenRef *SecretRef `json:"tokenRef,omitempty"`
+
+	// TLS enables TLS with the same settings as mysql.tls
+	// If not set, the connection is plaintext (sidecars, service meshes)
+	// +optional
+	TLS *MySQLTLS `json:"tls,omitempty"`
+}
+
+// DuplicateUIDPolicy defines how a uid returned by several shards is resolved
+// +kubebuilder:validation:Enum=Error;FirstWins;ShardPriority
+type DuplicateUIDPolicy string
+
+const (
+	// DuplicateUIDPolicyError fails the sync when shards return the same uid
+	DuplicateUIDPolicyError DuplicateUIDPolicy = "Error"
+	// DuplicateUIDPolicyFirstWins keeps the row of the shard listed first
+	DuplicateUIDPolicyFirstWins DuplicateUIDPolicy = "FirstWins"
+	// DuplicateUIDPolicyShardPriority keeps the row of the shard with the highest priority
+	// (the shard listed first on ties)
+	DuplicateUIDPolicyShardPriority DuplicateUIDPolicy = "ShardPriority"
+)
+
+// SourceShard is one of several sources with identical schemas federated into one hub.
+// It sets the block matching source.type.
+type SourceShard struct {
+	// Name identifies the shard in status and in the lynq.sh/shard label of its nodes
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Priority resolves duplicate uids with duplicateUIDPolicy=ShardPriority (higher wins)
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// MySQL contains the connection of a mysql shard
+	// +optional
+	MySQL *MySQLSource `json:"mysql,omitempty"`
+
+	// Postgres contains the connection of a postgresql shard
+	// +optional
+	Postgres *PostgreSQLSource `json:"postgres,omitempty"`
+
+	// HTTP contains the endpoint of an http shard
+	// +optional
+	HTTP *HTTPSource `json:"http,omitempty"`
+
+	// Plugin contains the endpoint of a plugin shard
+	// +optional
+	Plugin *PluginSource `json:"plugin,omitempty"`
 }
 
 // DataSource defines the external data source configuration
@@ -73,9 +394,82 @@ type DataSource struct {
 	// +kubebuilder:default="30s"
 	SyncInterval string `json:"syncInterval"`
 
+	// Schedule is a cron schedule (minute hour day-of-month month day-of-week, e.g. "0 2 * * *")
+	// evaluated in UTC unless prefixed with CRON_TZ=<zone>. When set, the hub syncs at the
+	// scheduled times instead of every syncInterval; failed syncs are retried after syncInterval.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
 	// MySQL contains MySQL-specific configuration
 	// +optional
 	MySQL *MySQLSource `json:"mysql,omitempty"`
+
+	// Postgres contains PostgreSQL-specific configuration
+	// +optional
+	Postgres *PostgreSQLSource `json:"postgres,omitempty"`
+
+	// HTTP contains JSON REST API configuration
+	// +optional
+	HTTP *HTTPSource `json:"http,omitempty"`
+
+	// Kubernetes contains ConfigMap, Secret or inline row configuration
+	// +optional
+	Kubernetes *KubernetesSource `json:"kubernetes,omitempty"`
+
+	// Plugin contains gRPC datasource plugin configuration
+	// +optional
+	Plugin *PluginSource `json:"plugin,omitempty"`
+
+	// Connection tunes the connection pool and timeouts
+	// +optional
+	Connection *ConnectionSettings `json:"connection,omitempty"`
+
+	// Shards federates several sources with identical schemas into this hub; their rows are unioned.
+	// Each shard sets the block matching type, and the top-level block (e.g. mysql) must not be set.
+	// A failing shard is reported in status.shards without failing the sync of the others.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Shards []SourceShard `json:"shards,omitempty"`
+
+	// DuplicateUIDPolicy resolves a uid returned by several shards (default: Error)
+	// +optional
+	DuplicateUIDPolicy DuplicateUIDPolicy `json:"duplicateUIDPolicy,omitempty"`
+
+	// PageSize reads rows in pages of this size ordered by the uid column (keyset pagination)
+	// and processes nodes page by page, keeping memory bounded for very large tables.
+	// When unset, all rows are read with a single query.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PageSize int32 `json:"pageSize,omitempty"`
+}
+
+// ConnectionSettings tunes the datasource connection pool and timeouts
+type ConnectionSettings struct {
+	// MaxOpenConns is the maximum number of open connections (default: 25)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxOpenConns int32 `json:"maxOpenConns,omitempty"`
+
+	// MaxIdleConns is the maximum number of idle connections kept in the pool (default: 5)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxIdleConns int32 `json:"maxIdleConns,omitempty"`
+
+	// ConnMaxLifetime is the maximum time a connection may be reused (default: 5m)
+	// +kubebuilder:validation:Pattern=`^[0-9]+(s|m|h)$`
+	// +optional
+	ConnMaxLifetime string `json:"connMaxLifetime,omitempty"`
+
+	// ConnectTimeout limits establishing a new connection (default: 5s)
+	// +kubebuilder:validation:Pattern=`^[0-9]+(s|m|h)$`
+	// +optional
+	ConnectTimeout string `json:"connectTimeout,omitempty"`
+
+	// QueryTimeout limits a single sync query, so a slow query cannot block a hub worker (default: 1m)
+	// +kubebuilder:validation:Pattern=`^[0-9]+(s|m|h)$`
+	// +optional
+	QueryTimeout string `json:"queryTimeout,omitempty"`
 }
 
 // ValueMappings defines required column mappings
@@ -93,6 +487,247 @@ type ValueMappings struct {
 	// Activate is the column name for the activation status
 	// +kubebuilder:validation:Required
 	Activate string `json:"activate"`
+
+	// Activation decides which activate column values mark a row as active
+	// When unset, "1", "true" and "yes" (in lower, upper or title case) are active
+	// +optional
+	Activation *ActivationRule `json:"activation,omitempty"`
+
+	// ActiveFrom is the column name of an optional timestamp before which the row is inactive
+	// NULL or empty values do not restrict activation
+	// +optional
+	ActiveFrom string `json:"activeFrom,omitempty"`
+
+	// ActiveUntil is the column name of an optional timestamp from which on the row is inactive
+	// NULL or empty values do not restrict activation
+	// +optional
+	ActiveUntil string `json:"activeUntil,omitempty"`
+}
+
+// ActivationOperator compares the activate column with ActivationRule values
+// +kubebuilder:validation:Enum=in;notIn
+type ActivationOperator string
+
+const (
+	// ActivationOperatorIn activates rows whose value is one of the values
+	ActivationOperatorIn ActivationOperator = "in"
+	// ActivationOperatorNotIn activates rows whose value is none of the values
+	ActivationOperatorNotIn ActivationOperator = "notIn"
+)
+
+// ActivationRule matches the activate column against a set of values,
+// e.g. operator: in, values: [active, trial]
+type ActivationRule struct {
+	// Operator is the comparison applied to Values
+	// +kubebuilder:default=in
+	// +optional
+	Operator ActivationOperator `json:"operator,omitempty"`
+
+	// Values is the set of values compared with the activate column
+	// Leading and trailing whitespace of the column value is ignored. NULL compares as ""
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+
+	// CaseInsensitive compares values ignoring case
+	// +optional
+	CaseInsensitive bool `json:"caseInsensitive,omitempty"`
+}
+
+// ValueType is the template variable type of an extra value mapping
+// +kubebuilder:validation:Enum=auto;string;int;float;bool;json
+type ValueType string
+
+const (
+	// ValueTypeAuto derives the type from the column's database type
+	ValueTypeAuto ValueType = "auto"
+	// ValueTypeString passes the value as a string (NULL becomes "")
+	ValueTypeString ValueType = "string"
+	// ValueTypeInt parses the value as a 64-bit integer
+	ValueTypeInt ValueType = "int"
+	// ValueTypeFloat parses the value as a 64-bit float
+	ValueTypeFloat ValueType = "float"
+	// ValueTypeBool parses the value as a boolean (true/false, 1/0, yes/no)
+	ValueTypeBool ValueType = "bool"
+	// ValueTypeJSON parses the value as a JSON document (object, array or scalar)
+	ValueTypeJSON ValueType = "json"
+)
+
+// ExtraValueType sets the type of an extraValueMappings variable
+type ExtraValueType struct {
+	// Type is the template variable type
+	// Defaults to auto; mappings without an extraValueTypes entry are always strings
+	// +optional
+	Type ValueType `json:"type,omitempty"`
+
+	// Path is a JSONPath selecting a nested value of a JSON column (e.g. $.limits.seats)
+	// With type auto, the type follows the selected JSON value
+	// +kubebuilder:validation:Pattern=`^\$`
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// Relation maps the child rows of a related table to a list variable.
+// Each child row whose foreign key equals the node uid becomes one map in the list.
+type Relation struct {
+	// Table is the child table (postgresql: in the schema of the source)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Table string `json:"table"`
+
+	// ForeignKey is the child table column holding the uid of the parent row
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ForeignKey string `json:"foreignKey"`
+
+	// Columns maps the keys of each list item to child table columns
+	// Values are strings; NULL becomes an empty string
+	// +kubebuilder:validation:MinProperties=1
+	Columns map[string]string `json:"columns"`
+
+	// OrderBy is the column the list is sorted by (ascending)
+	// Defaults to the mapped columns in key order so the list is stable between syncs
+	// +optional
+	OrderBy string `json:"orderBy,omitempty"`
+}
+
+// FilterOperator defines the comparison applied by a FilterCondition
+// +kubebuilder:validation:Enum=eq;ne;gt;gte;lt;lte;in;notIn;like;isNull;isNotNull
+type FilterOperator string
+
+const (
+	FilterOperatorEquals             FilterOperator = "eq"
+	FilterOperatorNotEquals          FilterOperator = "ne"
+	FilterOperatorGreaterThan        FilterOperator = "gt"
+	FilterOperatorGreaterThanOrEqual FilterOperator = "gte"
+	FilterOperatorLessThan           FilterOperator = "lt"
+	FilterOperatorLessThanOrEqual    FilterOperator = "lte"
+	FilterOperatorIn                 FilterOperator = "in"
+	FilterOperatorNotIn              FilterOperator = "notIn"
+	FilterOperatorLike               FilterOperator = "like"
+	FilterOperatorIsNull             FilterOperator = "isNull"
+	FilterOperatorIsNotNull          FilterOperator = "isNotNull"
+)
+
+// FilterCondition is a single column predicate pushed down to the data source
+// Values are always sent as bound query parameters, never concatenated into SQL
+type FilterCondition struct {
+	// Column is the column name to compare
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Column string `json:"column"`
+
+	// Operator is the comparison operator
+	// +kubebuilder:validation:Required
+	Operator FilterOperator `json:"operator"`
+
+	// Value is the operand for eq, ne, gt, gte, lt, lte and like
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Values are the operands for in and notIn
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// RowFilter restricts which rows are read from the data source
+type RowFilter struct {
+	// Conditions are combined with AND
+	// +kubebuilder:validation:MinItems=1
+	Conditions []FilterCondition `json:"conditions"`
+}
+
+// ChangeTracking enables incremental syncs based on a last-modified column
+type ChangeTracking struct {
+	// Column is the last-modified timestamp column (e.g. updated_at)
+	// Only rows with a value at or after the stored watermark are read between full resyncs
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Column string `json:"column"`
+
+	// FullResyncInterval is how often a full sync runs to catch deleted rows
+	// +kubebuilder:validation:Pattern=`^[0-9]+(s|m|h)$`
+	// +kubebuilder:default="10m"
+	// +optional
+	FullResyncInterval string `json:"fullResyncInterval,omitempty"`
+}
+
+// DeactivationPolicy defines what happens to the LynqNodes of inactive rows
+// +kubebuilder:validation:Enum=Delete;Suspend
+type DeactivationPolicy string
+
+const (
+	// DeactivationPolicyDelete deletes the LynqNodes of inactive rows (default)
+	DeactivationPolicyDelete DeactivationPolicy = "Delete"
+	// DeactivationPolicySuspend keeps the LynqNodes of inactive rows and stops their workloads
+	DeactivationPolicySuspend DeactivationPolicy = "Suspend"
+)
+
+// DeletionSafety limits how many LynqNodes one sync may garbage collect.
+// When a sync would delete more, none are deleted until the hub is annotated with
+// lynq.sh/allow-deletions=true, which approves the next sweep and is then removed.
+// +kubebuilder:validation:MinProperties=1
+type DeletionSafety struct {
+	// MaxDeletions is the maximum number of LynqNodes one sync may delete
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxDeletions *int32 `json:"maxDeletions,omitempty"`
+
+	// MaxDeletionPercent is the maximum percentage of the existing LynqNodes one sync may delete
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxDeletionPercent *int32 `json:"maxDeletionPercent,omitempty"`
+}
+
+// WriteBack writes the provisioning status of every uid back to the source table.
+// The status is aggregated from the LynqNodes of the uid across all forms.
+// Only supported by mysql and postgresql sources; the database user needs UPDATE on the columns.
+type WriteBack struct {
+	// Table is the table updated by uid (postgresql: in the schema of the source)
+	// Defaults to the source table; required when the source uses a query
+	// +optional
+	Table string `json:"table,omitempty"`
+
+	// Columns are the columns the status is written to
+	// +kubebuilder:validation:Required
+	Columns WriteBackColumns `json:"columns"`
+
+	// BatchSize is the number of rows updated per transaction
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	// +kubebuilder:default=100
+	// +optional
+	BatchSize int32 `json:"batchSize,omitempty"`
+
+	// MinInterval is the minimum time between two write-backs
+	// Status changes in between are written by the next write-back
+	// +kubebuilder:validation:Pattern=`^[0-9]+(s|m|h)$`
+	// +kubebuilder:default="30s"
+	// +optional
+	MinInterval string `json:"minInterval,omitempty"`
+}
+
+// WriteBackColumns names the columns the status of a uid is written to; unset columns are not written
+// +kubebuilder:validation:MinProperties=1
+type WriteBackColumns struct {
+	// Ready is a boolean column set to true when every LynqNode of the uid is ready
+	// +optional
+	Ready string `json:"ready,omitempty"`
+
+	// Phase is a text column set to Ready, Progressing, Failed, Suspended, PendingDeletion or Inactive
+	// (Inactive once the uid has no LynqNodes left)
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Message is a text column set to the Ready condition message of the first LynqNode that is not ready
+	// Messages are truncated to 1024 characters
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastReconciled is a timestamp column set to when the status of the uid was written
+	// +optional
+	LastReconciled string `json:"lastReconciled,omitempty"`
 }
 
 // LynqHubSpec defines the desired state of LynqHub.
@@ -107,8 +742,68 @@ type LynqHubSpec struct {
 
 	// ExtraValueMappings defines additional custom column to variable mappings
 	// Keys become template variables, values are column names
+	// The variables are strings unless typed in extraValueTypes
 	// +optional
 	ExtraValueMappings map[string]string `json:"extraValueMappings,omitempty"`
+
+	// ExtraValueTypes types extraValueMappings variables, keyed by the same keys,
+	// e.g. {seats: {type: int}} or {sso: {type: bool, path: $.features.sso}}
+	// +optional
+	ExtraValueTypes map[string]ExtraValueType `json:"extraValueTypes,omitempty"`
+
+	// Relations expose child rows of related tables as list variables
+	// Keys become template variables holding a list of maps, e.g. .domains
+	// Only supported by mysql and postgresql sources
+	// +optional
+	Relations map[string]Relation `json:"relations,omitempty"`
+
+	// Filter restricts the rows read from the data source
+	// Allows multiple clusters to share one node table by each selecting their own slice
+	// +optional
+	Filter *RowFilter `json:"filter,omitempty"`
+
+	// ChangeTracking enables incremental syncs that only read rows changed since the last sync
+	// +optional
+	ChangeTracking *ChangeTracking `json:"changeTracking,omitempty"`
+
+	// DeactivationPolicy defines what happens to the LynqNodes of inactive rows
+	// Delete removes them; Suspend keeps them with Deployments and StatefulSets scaled to zero,
+	// HorizontalPodAutoscalers removed and CronJobs suspended, and sets the .suspended template variable
+	// Rows removed from the source are always deleted
+	// +kubebuilder:default=Delete
+	// +optional
+	DeactivationPolicy DeactivationPolicy `json:"deactivationPolicy,omitempty"`
+
+	// DeactivationGracePeriod delays the deletion of LynqNodes whose rows became inactive or were removed
+	// Until it expires the node is marked PendingDeletion and kept if its row becomes active again
+	// +kubebuilder:validation:Pattern=`^[0-9]+(s|m|h)$`
+	// +optional
+	DeactivationGracePeriod string `json:"deactivationGracePeriod,omitempty"`
+
+	// DeletionSafety blocks syncs that would delete an unexpected number of LynqNodes,
+	// e.g. because the source returned no rows after a table was truncated
+	// +optional
+	DeletionSafety *DeletionSafety `json:"deletionSafety,omitempty"`
+
+	// WriteBack writes the provisioning status of every uid back to the source database
+	// +optional
+	WriteBack *WriteBack `json:"writeBack,omitempty"`
+}
+
+// ChangeTrackingStatus records the incremental sync state of a hub
+type ChangeTrackingStatus struct {
+	// Watermark is the highest change tracking column value seen so far (RFC3339)
+	// +optional
+	Watermark string `json:"watermark,omitempty"`
+
+	// LastFullSyncTime is when the last full sync completed
+	// +optional
+	LastFullSyncTime *metav1.Time `json:"lastFullSyncTime,omitempty"`
+
+	// Fingerprint identifies the hub generation and templates the watermark was taken with
+	// A mismatch forces a full sync
+	// +optional
+	Fingerprint string `json:"fingerprint,omitempty"`
 }
 
 // LynqHubStatus defines the observed state of LynqHub.
@@ -134,6 +829,49 @@ type LynqHubStatus struct {
 	// +optional
 	Failed int32 `json:"failed,omitempty"`
 
+	// ChangeTracking holds the incremental sync watermark when spec.changeTracking is set
+	// +optional
+	ChangeTracking *ChangeTrackingStatus `json:"changeTracking,omitempty"`
+
+	// Shards reports the health of each shard of a federated hub
+	// +optional
+	Shards []ShardStatus `json:"shards,omitempty"`
+
+	// Quarantined is the number of uids whose rows were skipped by row validation
+	// +optional
+	Quarantined int32 `json:"quarantined,omitempty"`
+
+	// QuarantinedRows lists the skipped uids with the reason (sorted by uid, at most 100 entries)
+	// +optional
+	QuarantinedRows []QuarantinedRow `json:"quarantinedRows,omitempty"`
+
+	// NextActivationBoundary is when the next activeFrom/activeUntil timestamp of a row passes
+	// The hub runs a full sync at that time
+	// +optional
+	NextActivationBoundary *metav1.Time `json:"nextActivationBoundary,omitempty"`
+
+	// LastSyncTime is when the last sync completed successfully
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// NextScheduledSync is the next activation of spec.source.schedule
+	// +optional
+	NextScheduledSync *metav1.Time `json:"nextScheduledSync,omitempty"`
+
+	// ServingEndpoint is the host:port of the MySQL endpoint that served the last successful sync.
+	// It differs from the source host while a replica is used.
+	// +optional
+	ServingEndpoint string `json:"servingEndpoint,omitempty"`
+
+	// WriteBack reports the last write-back of node status to the source database
+	// +optional
+	WriteBack *WriteBackStatus `json:"writeBack,omitempty"`
+
+	// BlockedDeletions is the number of LynqNodes the last full sync did not delete
+	// because the deletionSafety limit was exceeded
+	// +optional
+	BlockedDeletions int32 `json:"blockedDeletions,omitempty"`
+
 	// Conditions represent the latest available observations of the hub's state
 	// +optional
 	// +patchMergeKey=type
@@ -141,6 +879,73 @@ type LynqHubStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
+// WriteBackStatus reports the status write-back of a hub
+type WriteBackStatus struct {
+	// LastWriteTime is when the last write-back ran
+	// +optional
+	LastWriteTime *metav1.Time `json:"lastWriteTime,omitempty"`
+
+	// WrittenRows is the number of uids whose status the last write-back wrote
+	// +optional
+	WrittenRows int32 `json:"writtenRows,omitempty"`
+
+	// Error describes why the last write-back failed
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// ShardStatus reports the health of one shard of a federated hub
+type ShardStatus struct {
+	// Name is the shard name
+	Name string `json:"name"`
+
+	// Healthy is false when the last query of the shard failed
+	Healthy bool `json:"healthy"`
+
+	// Rows is the number of active rows read from the shard by the last successful query
+	// +optional
+	Rows int32 `json:"rows,omitempty"`
+
+	// Message describes the last query failure
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ServingEndpoint is the host:port of the MySQL endpoint that served the last successful query
+	// +optional
+	ServingEndpoint string `json:"servingEndpoint,omitempty"`
+
+	// LastSyncTime is when the shard was last queried
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// QuarantineReason explains why a row was skipped
+type QuarantineReason string
+
+const (
+	// QuarantineReasonEmptyUID is set for rows without a uid
+	QuarantineReasonEmptyUID QuarantineReason = "EmptyUID"
+	// QuarantineReasonInvalidUID is set for uids that are not valid DNS labels
+	QuarantineReasonInvalidUID QuarantineReason = "InvalidUID"
+	// QuarantineReasonDuplicateUID is set for uids returned by more than one row
+	QuarantineReasonDuplicateUID QuarantineReason = "DuplicateUID"
+	// QuarantineReasonInvalidActiveWindow is set for activeFrom/activeUntil values that are not timestamps
+	QuarantineReasonInvalidActiveWindow QuarantineReason = "InvalidActiveWindow"
+)
+
+// QuarantinedRow is a uid whose rows were skipped by row validation
+type QuarantinedRow struct {
+	// UID is the uid of the row (empty for EmptyUID)
+	UID string `json:"uid"`
+
+	// Reason is EmptyUID, InvalidUID, DuplicateUID or InvalidActiveWindow
+	Reason QuarantineReason `json:"reason"`
+
+	// Message describes the problem
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Templates",type="integer",JSONPath=".status.referencingTemplates",description="Number of referencing templates"
@@ -18,13 +18,22 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/k8s-lynq/lynq/internal/fieldfilter"
+	"github.com/k8s-lynq/lynq/internal/schedule"
 )
 
 // log is for logging in this package.
@@ -60,14 +69,79 @@ func (d *LynqHubDefaulter) Default(ctx context.Context, obj runtime.Object) erro
 		registry.Spec.Source.SyncInterval = "30s"
 	}
 
-	// Set default MySQL port
-	if registry.Spec.Source.MySQL != nil && registry.Spec.Source.MySQL.Port == 0 {
-		registry.Spec.Source.MySQL.Port = 3306
+	// Set connection defaults of the source and of each shard
+	defaultSourceBlocks(registry.Spec.Source.MySQL, registry.Spec.Source.Postgres, registry.Spec.Source.HTTP)
+	for i := range registry.Spec.Source.Shards {
+		shard := &registry.Spec.Source.Shards[i]
+		defaultSourceBlocks(shard.MySQL, shard.Postgres, shard.HTTP)
+	}
+	if len(registry.Spec.Source.Shards) > 0 && registry.Spec.Source.DuplicateUIDPolicy == "" {
+		registry.Spec.Source.DuplicateUIDPolicy = DuplicateUIDPolicyError
+	}
+
+	// Set default activation operator
+	if registry.Spec.ValueMappings.Activation != nil && registry.Spec.ValueMappings.Activation.Operator == "" {
+		registry.Spec.ValueMappings.Activation.Operator = ActivationOperatorIn
+	}
+
+	// Set default payload format for Kubernetes sources
+	if registry.Spec.Source.Kubernetes != nil && registry.Spec.Source.Kubernetes.Format == "" {
+		registry.Spec.Source.Kubernetes.Format = KubernetesRowFormatYAML
+	}
+
+	// Set default full resync interval for change tracking
+	if registry.Spec.ChangeTracking != nil && registry.Spec.ChangeTracking.FullResyncInterval == "" {
+		registry.Spec.ChangeTracking.FullResyncInterval = "10m"
+	}
+
+	// Set write-back batch size and rate limit defaults
+	if wb := registry.Spec.WriteBack; wb != nil {
+		if wb.BatchSize == 0 {
+			wb.BatchSize = 100
+		}
+		if wb.MinInterval == "" {
+			wb.MinInterval = "30s"
+		}
 	}
 
 	return nil
 }
 
+// defaultSourceBlocks sets the defaults of the connection blocks of a source or shard
+func defaultSourceBlocks(mysql *MySQLSource, postgres *PostgreSQLSource, http *HTTPSource) {
+	// Set default MySQL ports
+	if mysql != nil {
+		if mysql.Port == 0 {
+			mysql.Port = 3306
+		}
+		for i := range mysql.Replicas {
+			if mysql.Replicas[i].Port == 0 {
+				mysql.Replicas[i].Port = 3306
+			}
+		}
+	}
+
+	// Set default PostgreSQL port and sslMode
+	if postgres != nil {
+		if postgres.Port == 0 {
+			postgres.Port = 5432
+		}
+		if postgres.SSLMode == "" {
+			postgres.SSLMode = PostgreSQLSSLModeRequire
+		}
+	}
+
+	// Set default rows path and page limit for HTTP sources
+	if http != nil {
+		if http.RowsPath == "" {
+			http.RowsPath = "$"
+		}
+		if p := http.Pagination; p != nil && p.MaxPages == 0 {
+			p.MaxPages = 1000
+		}
+	}
+}
+
 // +kubebuilder:webhook:path=/validate-operator-lynq-sh-v1-lynqhub,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.lynq.sh,resources=lynqhubs,verbs=create;update,versions=v1,name=vlynqhub.kb.io,admissionReviewVersions=v1
 
 // LynqHubValidator handles validation for LynqHub
@@ -126,24 +200,831 @@ func (v *LynqHubValidator) validateLynqHub(ctx context.Context, registry *LynqHu
 				"Use extraValueMappings with the toHost() template function instead.")
 	}
 
+	// Validate activation rule
+	if err := validateActivationRule(registry.Spec.ValueMappings.Activation); err != nil {
+		return warnings, err
+	}
+
+	// Validate extra value types
+	if err := validateExtraValueTypes(&registry.Spec); err != nil {
+		return warnings, err
+	}
+
+	// Validate relations
+	if err := validateRelations(&registry.Spec); err != nil {
+		return warnings, err
+	}
+
+	// Validate row filter
+	if err := validateRowFilter(registry.Spec.Filter); err != nil {
+		return warnings, err
+	}
+
+	// Validate change tracking
+	if err := validateChangeTracking(registry.Spec.ChangeTracking); err != nil {
+		return warnings, err
+	}
+
+	// The suspended variable is set by the operator
+	if _, ok := registry.Spec.ExtraValueMappings["suspended"]; ok {
+		warnings = append(warnings,
+			"extraValueMappings.suspended is shadowed by the suspended template variable set by the operator")
+	}
+
+	// Validate deactivation grace period
+	if period := registry.Spec.DeactivationGracePeriod; period != "" {
+		if _, err := time.ParseDuration(period); err != nil {
+			return warnings, fmt.Errorf("deactivationGracePeriod is invalid: %w", err)
+		}
+	}
+
+	// Validate sync schedule
+	if spec := registry.Spec.Source.Schedule; spec != "" {
+		sched, err := schedule.Parse(spec)
+		if err != nil {
+			return warnings, fmt.Errorf("source.schedule is invalid: %w", err)
+		}
+		if sched.Next(time.Now()).IsZero() {
+			return warnings, fmt.Errorf("source.schedule %q never runs", spec)
+		}
+	}
+
+	// Validate deletion safety
+	if err := validateDeletionSafety(registry.Spec.DeletionSafety); err != nil {
+		return warnings, err
+	}
+
+	// Validate status write-back
+	if err := validateWriteBack(&registry.Spec); err != nil {
+		return warnings, err
+	}
+
+	// Validate connection settings
+	if err := validateConnectionSettings(registry.Spec.Source.Connection); err != nil {
+		return warnings, err
+	}
+
 	// Validate source configuration
-	if registry.Spec.Source.Type == SourceTypeMySQL {
-		if registry.Spec.Source.MySQL == nil {
+	if len(registry.Spec.Source.Shards) > 0 {
+		shardWarnings, err := validateShards(&registry.Spec)
+		warnings = append(warnings, shardWarnings...)
+		if err != nil {
+			return warnings, err
+		}
+	} else {
+		if registry.Spec.Source.DuplicateUIDPolicy != "" {
+			return warnings, fmt.Errorf("source.duplicateUIDPolicy requires source.shards")
+		}
+		sourceWarnings, err := validateSourceBlock(&registry.Spec)
+		warnings = append(warnings, sourceWarnings...)
+		if err != nil {
+			return warnings, err
+		}
+	}
+
+	return warnings, nil
+}
+
+// validateSourceBlock validates the block of spec.source matching its type
+func validateSourceBlock(spec *LynqHubSpec) (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	switch spec.Source.Type {
+	case SourceTypeMySQL:
+		if spec.Source.MySQL == nil {
 			return warnings, fmt.Errorf("mysql configuration is required when source type is mysql")
 		}
-		if registry.Spec.Source.MySQL.Host == "" {
+		if spec.Source.MySQL.Host == "" {
 			return warnings, fmt.Errorf("mysql.host is required")
 		}
-		if registry.Spec.Source.MySQL.Username == "" {
+		if spec.Source.MySQL.Username == "" {
 			return warnings, fmt.Errorf("mysql.username is required")
 		}
-		if registry.Spec.Source.MySQL.Database == "" {
+		if spec.Source.MySQL.Database == "" {
 			return warnings, fmt.Errorf("mysql.database is required")
 		}
-		if registry.Spec.Source.MySQL.Table == "" {
-			return warnings, fmt.Errorf("mysql.table is required")
+		if err := validateTableOrQuery("mysql", spec.Source.MySQL.Table, spec.Source.MySQL.Query); err != nil {
+			return warnings, err
+		}
+		tlsWarnings, err := validateTLS("mysql.tls", spec.Source.MySQL.TLS)
+		warnings = append(warnings, tlsWarnings...)
+		if err != nil {
+			return warnings, err
+		}
+		if err := validateMySQLReplicas(spec.Source.MySQL); err != nil {
+			return warnings, err
+		}
+	case SourceTypePostgreSQL:
+		if err := validatePostgreSQLSource(spec.Source.Postgres); err != nil {
+			return warnings, err
+		}
+	case SourceTypeHTTP:
+		httpWarnings, err := validateHTTPSource(spec)
+		warnings = append(warnings, httpWarnings...)
+		if err != nil {
+			return warnings, err
+		}
+	case SourceTypeKubernetes:
+		if err := validateKubernetesSource(spec); err != nil {
+			return warnings, err
+		}
+	case SourceTypePlugin:
+		pluginWarnings, err := validatePluginSource(spec.Source.Plugin)
+		warnings = append(warnings, pluginWarnings...)
+		if err != nil {
+			return warnings, err
+		}
+	}
+
+	return warnings, nil
+}
+
+// validateMySQLReplicas checks that every replica has a host and that no endpoint is listed twice
+func validateMySQLReplicas(mysql *MySQLSource) error {
+	if len(mysql.Replicas) > 10 {
+		return fmt.Errorf("mysql.replicas must not list more than 10 endpoints")
+	}
+	port := func(p int32) int32 {
+		if p == 0 {
+			return 3306
+		}
+		return p
+	}
+	seen := map[string]bool{fmt.Sprintf("%s:%d", mysql.Host, port(mysql.Port)): true}
+	for i, replica := range mysql.Replicas {
+		if replica.Host == "" {
+			return fmt.Errorf("mysql.replicas[%d].host is required", i)
+		}
+		if replica.Port < 0 || replica.Port > 65535 {
+			return fmt.Errorf("mysql.replicas[%d].port must be between 1 and 65535", i)
+		}
+		endpoint := fmt.Sprintf("%s:%d", replica.Host, port(replica.Port))
+		if seen[endpoint] {
+			return fmt.Errorf("mysql.replicas[%d]: endpoint %s is listed twice", i, endpoint)
+		}
+		seen[endpoint] = true
+	}
+	return nil
+}
+
+// validateShards validates the shards of a federated hub.
+// Each shard is validated like a source whose type block is the shard's block.
+func validateShards(spec *LynqHubSpec) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	src := spec.Source
+
+	switch {
+	case src.Type == SourceTypeKubernetes:
+		return warnings, fmt.Errorf("source.shards is not supported for kubernetes sources")
+	case src.MySQL != nil || src.Postgres != nil || src.HTTP != nil || src.Plugin != nil || src.Kubernetes != nil:
+		return warnings, fmt.Errorf("source.shards replaces the top-level %s block; move it into a shard", src.Type)
+	case spec.ChangeTracking != nil:
+		// Incremental syncs only see changed rows, so duplicate uids could not be resolved
+		return warnings, fmt.Errorf("changeTracking is not supported with source.shards")
+	}
+
+	switch src.DuplicateUIDPolicy {
+	case "", DuplicateUIDPolicyError, DuplicateUIDPolicyFirstWins, DuplicateUIDPolicyShardPriority:
+	default:
+		return warnings, fmt.Errorf("source.duplicateUIDPolicy %q is not supported (use Error, FirstWins or ShardPriority)", src.DuplicateUIDPolicy)
+	}
+
+	seen := make(map[string]bool, len(src.Shards))
+	for _, shard := range src.Shards {
+		if shard.Name == "" {
+			return warnings, fmt.Errorf("source.shards[*].name is required")
+		}
+		if seen[shard.Name] {
+			return warnings, fmt.Errorf("source.shards: duplicate shard name %q", shard.Name)
+		}
+		seen[shard.Name] = true
+
+		blocks := 0
+		for _, set := range []bool{shard.MySQL != nil, shard.Postgres != nil, shard.HTTP != nil, shard.Plugin != nil} {
+			if set {
+				blocks++
+			}
+		}
+		if blocks > 1 {
+			return warnings, fmt.Errorf("source.shards[%s] must set only the %s block", shard.Name, src.Type)
+		}
+
+		shardSpec := *spec
+		shardSpec.Source.MySQL = shard.MySQL
+		shardSpec.Source.Postgres = shard.Postgres
+		shardSpec.Source.HTTP = shard.HTTP
+		shardSpec.Source.Plugin = shard.Plugin
+		shardWarnings, err := validateSourceBlock(&shardSpec)
+		for _, warning := range shardWarnings {
+			warnings = append(warnings, fmt.Sprintf("source.shards[%s]: %s", shard.Name, warning))
+		}
+		if err != nil {
+			return warnings, fmt.Errorf("source.shards[%s]: %w", shard.Name, err)
+		}
+	}
+
+	return warnings, nil
+}
+
+// validateTLS validates a TLS block; prefix is its field path (e.g. mysql.tls)
+func validateTLS(prefix string, tlsSpec *MySQLTLS) (admission.Warnings, error) {
+	if tlsSpec == nil {
+		return nil, nil
+	}
+	if (tlsSpec.ClientCertRef == nil) != (tlsSpec.ClientKeyRef == nil) {
+		return nil, fmt.Errorf("%[1]s.clientCertRef and %[1]s.clientKeyRef must be set together", prefix)
+	}
+	for field, ref := range map[string]*SecretRef{
+		"caRef":         tlsSpec.CARef,
+		"clientCertRef": tlsSpec.ClientCertRef,
+		"clientKeyRef":  tlsSpec.ClientKeyRef,
+	} {
+		if ref != nil && (ref.Name == "" || ref.Key == "") {
+			return nil, fmt.Errorf("%s.%s requires name and key", prefix, field)
+		}
+	}
+	if tlsSpec.InsecureSkipVerify {
+		return admission.Warnings{
+			prefix + ".insecureSkipVerify disables server certificate verification and should not be used in production",
+		}, nil
+	}
+	return nil, nil
+}
+
+// validatePostgreSQLSource validates the postgres block of a LynqHub source
+func validatePostgreSQLSource(pg *PostgreSQLSource) error {
+	if pg == nil {
+		return fmt.Errorf("postgres configuration is required when source type is postgresql")
+	}
+	if pg.Host == "" {
+		return fmt.Errorf("postgres.host is required")
+	}
+	if pg.Username == "" {
+		return fmt.Errorf("postgres.username is required")
+	}
+	if pg.Database == "" {
+		return fmt.Errorf("postgres.database is required")
+	}
+	if err := validateTableOrQuery("postgres", pg.Table, pg.Query); err != nil {
+		return err
+	}
+	if pg.Query != "" && pg.Schema != "" {
+		return fmt.Errorf("postgres.schema cannot be combined with postgres.query; qualify tables inside the query instead")
+	}
+
+	switch pg.SSLMode {
+	case "", PostgreSQLSSLModeDisable, PostgreSQLSSLModeRequire,
+		PostgreSQLSSLModeVerifyCA, PostgreSQLSSLModeVerifyFull:
+	default:
+		return fmt.Errorf("postgres.sslMode %q is not supported (use disable, require, verify-ca or verify-full)", pg.SSLMode)
+	}
+
+	return nil
+}
+
+// validateHTTPSource validates the http block and the spec features HTTP sources do not support
+func validateHTTPSource(spec *LynqHubSpec) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	src := spec.Source.HTTP
+	if src == nil {
+		return warnings, fmt.Errorf("http configuration is required when source type is http")
+	}
+
+	endpoint, err := url.Parse(src.URL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return warnings, fmt.Errorf("http.url must be an absolute http or https URL")
+	}
+	if endpoint.User != nil {
+		return warnings, fmt.Errorf("http.url must not contain credentials; use http.auth instead")
+	}
+	for name := range src.Headers {
+		if strings.EqualFold(name, "Authorization") {
+			return warnings, fmt.Errorf("http.headers must not set Authorization; use http.auth instead")
+		}
+	}
+
+	if auth := src.Auth; auth != nil {
+		switch auth.Type {
+		case HTTPAuthTypeBearer:
+			if auth.Username != "" {
+				return warnings, fmt.Errorf("http.auth.username is only used with basic auth")
+			}
+		case HTTPAuthTypeBasic:
+			if auth.Username == "" {
+				return warnings, fmt.Errorf("http.auth.username is required for basic auth")
+			}
+		default:
+			return warnings, fmt.Errorf("http.auth.type %q is not supported (use bearer or basic)", auth.Type)
+		}
+		if auth.SecretRef.Name == "" || auth.SecretRef.Key == "" {
+			return warnings, fmt.Errorf("http.auth.secretRef requires name and key")
+		}
+		if endpoint.Scheme == "http" {
+			warnings = append(warnings, "http.auth credentials are sent over plain http; use an https URL")
+		}
+	}
+
+	if src.RowsPath != "" {
+		if err := fieldfilter.ValidateJSONPath(src.RowsPath); err != nil {
+			return warnings, fmt.Errorf("http.rowsPath: %w", err)
+		}
+	}
+
+	if p := src.Pagination; p != nil {
+		switch p.Type {
+		case HTTPPaginationNextLink:
+			if p.CursorPath != "" || p.CursorParam != "" {
+				return warnings, fmt.Errorf("http.pagination cursorPath and cursorParam are only used with cursor pagination")
+			}
+			if p.NextLinkPath != "" {
+				if err := fieldfilter.ValidateJSONPath(p.NextLinkPath); err != nil {
+					return warnings, fmt.Errorf("http.pagination.nextLinkPath: %w", err)
+				}
+			}
+		case HTTPPaginationCursor:
+			if p.CursorPath == "" || p.CursorParam == "" {
+				return warnings, fmt.Errorf("http.pagination.cursorPath and cursorParam are required for cursor pagination")
+			}
+			if err := fieldfilter.ValidateJSONPath(p.CursorPath); err != nil {
+				return warnings, fmt.Errorf("http.pagination.cursorPath: %w", err)
+			}
+		default:
+			return warnings, fmt.Errorf("http.pagination.type %q is not supported (use nextLink or cursor)", p.Type)
 		}
 	}
 
+	if err := validateRowFieldPaths(spec); err != nil {
+		return warnings, err
+	}
+
+	// SQL-only features
+	switch {
+	case spec.Filter != nil:
+		return warnings, fmt.Errorf("filter is not supported for http sources; filter in the API query string instead")
+	case spec.ChangeTracking != nil:
+		return warnings, fmt.Errorf("changeTracking is not supported for http sources")
+	case spec.Source.PageSize != 0:
+		return warnings, fmt.Errorf("source.pageSize is not supported for http sources; use http.pagination instead")
+	}
+
 	return warnings, nil
 }
+
+// validateKubernetesSource validates the kubernetes block and the spec features Kubernetes sources do not support
+func validateKubernetesSource(spec *LynqHubSpec) error {
+	src := spec.Source.Kubernetes
+	if src == nil {
+		return fmt.Errorf("kubernetes configuration is required when source type is kubernetes")
+	}
+
+	set := 0
+	if src.ConfigMapRef != nil {
+		set++
+		if src.ConfigMapRef.Name == "" || src.ConfigMapRef.Key == "" {
+			return fmt.Errorf("kubernetes.configMapRef requires name and key")
+		}
+	}
+	if src.SecretRef != nil {
+		set++
+		if src.SecretRef.Name == "" || src.SecretRef.Key == "" {
+			return fmt.Errorf("kubernetes.secretRef requires name and key")
+		}
+	}
+	if len(src.Rows) > 0 {
+		set++
+	}
+	if set != 1 {
+		return fmt.Errorf("exactly one of kubernetes.configMapRef, kubernetes.secretRef or kubernetes.rows must be set")
+	}
+
+	switch src.Format {
+	case "", KubernetesRowFormatYAML, KubernetesRowFormatJSON, KubernetesRowFormatCSV:
+	default:
+		return fmt.Errorf("kubernetes.format %q is not supported (use yaml, json or csv)", src.Format)
+	}
+
+	for i, row := range src.Rows {
+		var obj map[string]interface{}
+		if err := json.Unmarshal(row.Raw, &obj); err != nil || obj == nil {
+			return fmt.Errorf("kubernetes.rows[%d] must be an object", i)
+		}
+	}
+
+	if err := validateRowFieldPaths(spec); err != nil {
+		return err
+	}
+
+	// SQL-only features
+	switch {
+	case spec.Filter != nil:
+		return fmt.Errorf("filter is not supported for kubernetes sources")
+	case spec.ChangeTracking != nil:
+		return fmt.Errorf("changeTracking is not supported for kubernetes sources")
+	case spec.Source.PageSize != 0:
+		return fmt.Errorf("source.pageSize is not supported for kubernetes sources")
+	}
+
+	return nil
+}
+
+// validatePluginSource validates the plugin block of a LynqHub source
+func validatePluginSource(plugin *PluginSource) (admission.Warnings, error) {
+	if plugin == nil {
+		return nil, fmt.Errorf("plugin configuration is required when source type is plugin")
+	}
+	if strings.TrimSpace(plugin.Endpoint) == "" {
+		return nil, fmt.Errorf("plugin.endpoint is required")
+	}
+	if ref := plugin.TokenRef; ref != nil && (ref.Name == "" || ref.Key == "") {
+		return nil, fmt.Errorf("plugin.tokenRef requires name and key")
+	}
+	return validateTLS("plugin.tls", plugin.TLS)
+}
+
+// validateRowFieldPaths validates the value mappings of object sources (http, kubernetes):
+// mappings starting with $ are JSONPaths evaluated against each row
+func validateRowFieldPaths(spec *LynqHubSpec) error {
+	fields := map[string]string{
+		"valueMappings.uid":       spec.ValueMappings.UID,
+		"valueMappings.activate":  spec.ValueMappings.Activate,
+		"valueMappings.hostOrUrl": spec.ValueMappings.HostOrURL,
+	}
+	for key, column := range spec.ExtraValueMappings {
+		fields["extraValueMappings."+key] = column
+	}
+	for name, field := range fields {
+		if strings.HasPrefix(field, "$") {
+			if err := fieldfilter.ValidateJSONPath(field); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateConnectionSettings checks pool sizes and timeouts of source.connection
+func validateConnectionSettings(conn *ConnectionSettings) error {
+	if conn == nil {
+		return nil
+	}
+	if conn.MaxOpenConns < 0 || conn.MaxIdleConns < 0 {
+		return fmt.Errorf("source.connection pool sizes must not be negative")
+	}
+	if conn.MaxOpenConns > 0 && conn.MaxIdleConns > conn.MaxOpenConns {
+		return fmt.Errorf("source.connection.maxIdleConns (%d) must not exceed maxOpenConns (%d)",
+			conn.MaxIdleConns, conn.MaxOpenConns)
+	}
+	for field, value := range map[string]string{
+		"connMaxLifetime": conn.ConnMaxLifetime,
+		"connectTimeout":  conn.ConnectTimeout,
+		"queryTimeout":    conn.QueryTimeout,
+	} {
+		if value == "" {
+			continue
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("source.connection.%s is invalid: %w", field, err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("source.connection.%s must be greater than zero", field)
+		}
+	}
+	return nil
+}
+
+// validateActivationRule checks that the activation rule is explicit and unambiguous
+func validateActivationRule(rule *ActivationRule) error {
+	if rule == nil {
+		return nil
+	}
+	switch rule.Operator {
+	case "", ActivationOperatorIn, ActivationOperatorNotIn:
+	default:
+		return fmt.Errorf("valueMappings.activation.operator %q is not supported (must be in or notIn)", rule.Operator)
+	}
+	if len(rule.Values) == 0 {
+		return fmt.Errorf("valueMappings.activation.values must contain at least one value")
+	}
+
+	seen := make(map[string]bool, len(rule.Values))
+	for _, value := range rule.Values {
+		if value != strings.TrimSpace(value) {
+			// Column values are trimmed before comparison, so this value could never match
+			return fmt.Errorf("valueMappings.activation.values entry %q must not have leading or trailing whitespace", value)
+		}
+		key := value
+		if rule.CaseInsensitive {
+			key = strings.ToLower(value)
+		}
+		if seen[key] {
+			return fmt.Errorf("valueMappings.activation.values contains duplicate value %q", value)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// validateExtraValueTypes checks that every extraValueTypes entry types an extraValueMappings key
+// and that its path is a valid JSONPath
+func validateExtraValueTypes(spec *LynqHubSpec) error {
+	keys := make([]string, 0, len(spec.ExtraValueTypes))
+	for key := range spec.ExtraValueTypes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if _, ok := spec.ExtraValueMappings[key]; !ok {
+			return fmt.Errorf("extraValueTypes.%s has no extraValueMappings entry", key)
+		}
+		if path := spec.ExtraValueTypes[key].Path; path != "" {
+			if err := fieldfilter.ValidateJSONPath(path); err != nil {
+				return fmt.Errorf("extraValueTypes.%s.path: %w", key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// reservedVariables are template variables set for every node
+var reservedVariables = map[string]bool{"uid": true, "activate": true, "hostOrUrl": true, "host": true, "suspended": true}
+
+// validateRelations checks that relations are only used with SQL sources and that
+// their keys do not shadow other template variables
+func validateRelations(spec *LynqHubSpec) error {
+	if len(spec.Relations) == 0 {
+		return nil
+	}
+	if spec.Source.Type != SourceTypeMySQL && spec.Source.Type != SourceTypePostgreSQL {
+		return fmt.Errorf("relations are only supported for mysql and postgresql sources")
+	}
+
+	keys := make([]string, 0, len(spec.Relations))
+	for key := range spec.Relations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		relation := spec.Relations[key]
+		if reservedVariables[key] {
+			return fmt.Errorf("relations.%s: %s is a reserved template variable", key, key)
+		}
+		if _, ok := spec.ExtraValueMappings[key]; ok {
+			return fmt.Errorf("relations.%s: key is already used by extraValueMappings", key)
+		}
+		if relation.Table == "" {
+			return fmt.Errorf("relations.%s.table is required", key)
+		}
+		if relation.ForeignKey == "" {
+			return fmt.Errorf("relations.%s.foreignKey is required", key)
+		}
+		if len(relation.Columns) == 0 {
+			return fmt.Errorf("relations.%s.columns must map at least one column", key)
+		}
+		for item, column := range relation.Columns {
+			if item == "" || column == "" {
+				return fmt.Errorf("relations.%s.columns must not contain empty keys or columns", key)
+			}
+		}
+	}
+	return nil
+}
+
+// validateChangeTracking checks the change tracking column and full resync interval
+func validateChangeTracking(tracking *ChangeTracking) error {
+	if tracking == nil {
+		return nil
+	}
+	if tracking.Column == "" {
+		return fmt.Errorf("changeTracking.column is required")
+	}
+	if tracking.FullResyncInterval != "" {
+		interval, err := time.ParseDuration(tracking.FullResyncInterval)
+		if err != nil {
+			return fmt.Errorf("changeTracking.fullResyncInterval is invalid: %w", err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("changeTracking.fullResyncInterval must be greater than zero")
+		}
+	}
+	return nil
+}
+
+// validateDeletionSafety checks that at least one deletion limit is set
+func validateDeletionSafety(safety *DeletionSafety) error {
+	if safety == nil {
+		return nil
+	}
+	if safety.MaxDeletions == nil && safety.MaxDeletionPercent == nil {
+		return fmt.Errorf("deletionSafety requires maxDeletions or maxDeletionPercent")
+	}
+	if safety.MaxDeletions != nil && *safety.MaxDeletions < 0 {
+		return fmt.Errorf("deletionSafety.maxDeletions must not be negative")
+	}
+	if p := safety.MaxDeletionPercent; p != nil && (*p < 0 || *p > 100) {
+		return fmt.Errorf("deletionSafety.maxDeletionPercent must be between 0 and 100")
+	}
+	return nil
+}
+
+// validateWriteBack checks that write-back targets a single SQL source and names a table and columns
+func validateWriteBack(spec *LynqHubSpec) error {
+	wb := spec.WriteBack
+	if wb == nil {
+		return nil
+	}
+	if len(spec.Source.Shards) > 0 {
+		return fmt.Errorf("writeBack is not supported for sources with shards")
+	}
+	if spec.Source.Type != SourceTypeMySQL && spec.Source.Type != SourceTypePostgreSQL {
+		return fmt.Errorf("writeBack is only supported for mysql and postgresql sources")
+	}
+	usesQuery := (spec.Source.MySQL != nil && spec.Source.MySQL.Query != "") ||
+		(spec.Source.Postgres != nil && spec.Source.Postgres.Query != "")
+	if wb.Table == "" && usesQuery {
+		return fmt.Errorf("writeBack.table is required when the source uses a query")
+	}
+
+	columns := wb.Columns
+	if columns.Ready == "" && columns.Phase == "" && columns.Message == "" && columns.LastReconciled == "" {
+		return fmt.Errorf("writeBack.columns must name at least one column")
+	}
+	if wb.BatchSize < 0 || wb.BatchSize > 1000 {
+		return fmt.Errorf("writeBack.batchSize must be between 1 and 1000")
+	}
+	if wb.MinInterval != "" {
+		if _, err := time.ParseDuration(wb.MinInterval); err != nil {
+			return fmt.Errorf("writeBack.minInterval is invalid: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateRowFilter checks that every filter condition has the operands its operator needs
+func validateRowFilter(filter *RowFilter) error {
+	if filter == nil {
+		return nil
+	}
+	if len(filter.Conditions) == 0 {
+		return fmt.Errorf("filter.conditions must contain at least one condition")
+	}
+
+	for i, cond := range filter.Conditions {
+		path := fmt.Sprintf("filter.conditions[%d]", i)
+		if cond.Column == "" {
+			return fmt.Errorf("%s.column is required", path)
+		}
+
+		switch cond.Operator {
+		case FilterOperatorIsNull, FilterOperatorIsNotNull:
+			if cond.Value != "" || len(cond.Values) > 0 {
+				return fmt.Errorf("%s: operator %s does not take value or values", path, cond.Operator)
+			}
+		case FilterOperatorIn, FilterOperatorNotIn:
+			if len(cond.Values) == 0 {
+				return fmt.Errorf("%s: operator %s requires values", path, cond.Operator)
+			}
+			if cond.Value != "" {
+				return fmt.Errorf("%s: operator %s uses values, not value", path, cond.Operator)
+			}
+		case FilterOperatorEquals, FilterOperatorNotEquals,
+			FilterOperatorGreaterThan, FilterOperatorGreaterThanOrEqual,
+			FilterOperatorLessThan, FilterOperatorLessThanOrEqual,
+			FilterOperatorLike:
+			if len(cond.Values) > 0 {
+				return fmt.Errorf("%s: operator %s uses value, not values", path, cond.Operator)
+			}
+		default:
+			return fmt.Errorf("%s: unsupported operator %q", path, cond.Operator)
+		}
+	}
+
+	return nil
+}
+
+// validateTableOrQuery ensures exactly one of table or query is set and that a query is read-only
+func validateTableOrQuery(prefix, table, query string) error {
+	if table == "" && query == "" {
+		return fmt.Errorf("%s.table or %s.query is required", prefix, prefix)
+	}
+	if table != "" && query != "" {
+		return fmt.Errorf("%s.table and %s.query are mutually exclusive", prefix, prefix)
+	}
+	if query != "" {
+		if err := validateReadOnlyQuery(query); err != nil {
+			return fmt.Errorf("%s.query: %w", prefix, err)
+		}
+	}
+	return nil
+}
+
+// forbiddenQueryKeywords are statements, clauses and functions that write data, change schema
+// or session state, take locks or stall the connection
+var forbiddenQueryKeywords = map[string]bool{
+	"INSERT": true, "UPDATE": true, "DELETE": true, "MERGE": true, "UPSERT": true,
+	"CREATE": true, "ALTER": true, "DROP": true, "TRUNCATE": true, "RENAME": true,
+	"GRANT": true, "REVOKE": true, "CALL": true, "EXEC": true, "EXECUTE": true,
+	"LOCK": true, "INTO": true, "COPY": true, "LOAD": true, "HANDLER": true,
+	"SHARE": true, "NOWAIT": true, "SET": true, "SET_CONFIG": true,
+	"GET_LOCK": true, "RELEASE_LOCK": true, "RELEASE_ALL_LOCKS": true, "SLEEP": true, "BENCHMARK": true,
+}
+
+// forbiddenQueryPrefixes cover function families such as pg_advisory_xact_lock_shared and pg_sleep_for
+var forbiddenQueryPrefixes = []string{"PG_ADVISORY_", "PG_TRY_ADVISORY_", "PG_SLEEP"}
+
+// validateReadOnlyQuery checks that query is a single, parameterless SELECT statement.
+// String literals, quoted identifiers and comments are skipped so their contents
+// never trigger (or hide) a violation. The keyword check is best-effort; only a
+// read-only database account actually keeps the query from writing or locking.
+func validateReadOnlyQuery(query string) error {
+	code, err := stripQueryLiterals(query)
+	if err != nil {
+		return err
+	}
+
+	// A single trailing semicolon is tolerated, anything after it is a second statement
+	code = strings.TrimSpace(code)
+	code = strings.TrimSuffix(code, ";")
+	if strings.Contains(code, ";") {
+		return fmt.Errorf("must be a single statement")
+	}
+
+	// Bound parameters are not supported
+	for i, r := range code {
+		if r == '?' {
+			return fmt.Errorf("must not contain parameter placeholders")
+		}
+		if r == '$' && i+1 < len(code) && code[i+1] >= '0' && code[i+1] <= '9' {
+			return fmt.Errorf("must not contain parameter placeholders")
+		}
+	}
+
+	words := strings.FieldsFunc(strings.ToUpper(code), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	if len(words) == 0 || (words[0] != "SELECT" && words[0] != "WITH") {
+		return fmt.Errorf("must start with SELECT or WITH")
+	}
+	for _, word := range words {
+		if forbiddenQueryKeywords[word] {
+			return fmt.Errorf("must be read-only (found %s)", word)
+		}
+		for _, prefix := range forbiddenQueryPrefixes {
+			if strings.HasPrefix(word, prefix) {
+				return fmt.Errorf("must be read-only (found %s)", word)
+			}
+		}
+	}
+
+	return nil
+}
+
+// stripQueryLiterals replaces string literals, quoted identifiers and comments with spaces
+func stripQueryLiterals(query string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			// Quoted string or identifier; a doubled quote is an escaped quote
+			end := i + 1
+			for ; end < len(query); end++ {
+				if query[end] == '\\' && c == '\'' {
+					end++
+					continue
+				}
+				if query[end] == c {
+					if end+1 < len(query) && query[end+1] == c {
+						end++
+						continue
+					}
+					break
+				}
+			}
+			if end >= len(query) {
+				return "", fmt.Errorf("unterminated quoted string")
+			}
+			b.WriteByte(' ')
+			i = end
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			// Line comment
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+			b.WriteByte(' ')
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			// Block comment
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return "", fmt.Errorf("unterminated comment")
+			}
+			b.WriteByte(' ')
+			i += end + 3
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), nil
+}
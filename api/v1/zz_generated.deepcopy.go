@@ -25,6 +25,90 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivationRule) DeepCopyInto(out *ActivationRule) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivationRule.
+func (in *ActivationRule) DeepCopy() *ActivationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangeTracking) DeepCopyInto(out *ChangeTracking) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeTracking.
+func (in *ChangeTracking) DeepCopy() *ChangeTracking {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeTracking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangeTrackingStatus) DeepCopyInto(out *ChangeTrackingStatus) {
+	*out = *in
+	if in.LastFullSyncTime != nil {
+		in, out := &in.LastFullSyncTime, &out.LastFullSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeTrackingStatus.
+func (in *ChangeTrackingStatus) DeepCopy() *ChangeTrackingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeTrackingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapRef) DeepCopyInto(out *ConfigMapRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapRef.
+func (in *ConfigMapRef) DeepCopy() *ConfigMapRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSettings) DeepCopyInto(out *ConnectionSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSettings.
+func (in *ConnectionSettings) DeepCopy() *ConnectionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataSource) DeepCopyInto(out *DataSource) {
 	*out = *in
@@ -33,6 +117,38 @@ func (in *DataSource) DeepCopyInto(out *DataSource) {
 		*out = new(MySQLSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Postgres != nil {
+		in, out := &in.Postgres, &out.Postgres
+		*out = new(PostgreSQLSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(KubernetesSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(PluginSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(ConnectionSettings)
+		**out = **in
+	}
+	if in.Shards != nil {
+		in, out := &in.Shards, &out.Shards
+		*out = make([]SourceShard, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSource.
@@ -45,6 +161,161 @@ func (in *DataSource) DeepCopy() *DataSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionSafety) DeepCopyInto(out *DeletionSafety) {
+	*out = *in
+	if in.MaxDeletions != nil {
+		in, out := &in.MaxDeletions, &out.MaxDeletions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDeletionPercent != nil {
+		in, out := &in.MaxDeletionPercent, &out.MaxDeletionPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionSafety.
+func (in *DeletionSafety) DeepCopy() *DeletionSafety {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionSafety)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtraValueType) DeepCopyInto(out *ExtraValueType) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtraValueType.
+func (in *ExtraValueType) DeepCopy() *ExtraValueType {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtraValueType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterCondition) DeepCopyInto(out *FilterCondition) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterCondition.
+func (in *FilterCondition) DeepCopy() *FilterCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPAuth) DeepCopyInto(out *HTTPAuth) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPAuth.
+func (in *HTTPAuth) DeepCopy() *HTTPAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPagination) DeepCopyInto(out *HTTPPagination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPagination.
+func (in *HTTPPagination) DeepCopy() *HTTPPagination {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPagination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSource) DeepCopyInto(out *HTTPSource) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(HTTPAuth)
+		**out = **in
+	}
+	if in.Pagination != nil {
+		in, out := &in.Pagination, &out.Pagination
+		*out = new(HTTPPagination)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSource.
+func (in *HTTPSource) DeepCopy() *HTTPSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesSource) DeepCopyInto(out *KubernetesSource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapRef)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.Rows != nil {
+		in, out := &in.Rows, &out.Rows
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSource.
+func (in *KubernetesSource) DeepCopy() *KubernetesSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LynqForm) DeepCopyInto(out *LynqForm) {
 	*out = *in
@@ -361,7 +632,7 @@ func (in *LynqHubList) DeepCopyObject() runtime.Object {
 func (in *LynqHubSpec) DeepCopyInto(out *LynqHubSpec) {
 	*out = *in
 	in.Source.DeepCopyInto(&out.Source)
-	out.ValueMappings = in.ValueMappings
+	in.ValueMappings.DeepCopyInto(&out.ValueMappings)
 	if in.ExtraValueMappings != nil {
 		in, out := &in.ExtraValueMappings, &out.ExtraValueMappings
 		*out = make(map[string]string, len(*in))
@@ -369,6 +640,40 @@ func (in *LynqHubSpec) DeepCopyInto(out *LynqHubSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ExtraValueTypes != nil {
+		in, out := &in.ExtraValueTypes, &out.ExtraValueTypes
+		*out = make(map[string]ExtraValueType, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Relations != nil {
+		in, out := &in.Relations, &out.Relations
+		*out = make(map[string]Relation, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(RowFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChangeTracking != nil {
+		in, out := &in.ChangeTracking, &out.ChangeTracking
+		*out = new(ChangeTracking)
+		**out = **in
+	}
+	if in.DeletionSafety != nil {
+		in, out := &in.DeletionSafety, &out.DeletionSafety
+		*out = new(DeletionSafety)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteBack != nil {
+		in, out := &in.WriteBack, &out.WriteBack
+		*out = new(WriteBack)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LynqHubSpec.
@@ -384,6 +689,40 @@ func (in *LynqHubSpec) DeepCopy() *LynqHubSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LynqHubStatus) DeepCopyInto(out *LynqHubStatus) {
 	*out = *in
+	if in.ChangeTracking != nil {
+		in, out := &in.ChangeTracking, &out.ChangeTracking
+		*out = new(ChangeTrackingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Shards != nil {
+		in, out := &in.Shards, &out.Shards
+		*out = make([]ShardStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QuarantinedRows != nil {
+		in, out := &in.QuarantinedRows, &out.QuarantinedRows
+		*out = make([]QuarantinedRow, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextActivationBoundary != nil {
+		in, out := &in.NextActivationBoundary, &out.NextActivationBoundary
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduledSync != nil {
+		in, out := &in.NextScheduledSync, &out.NextScheduledSync
+		*out = (*in).DeepCopy()
+	}
+	if in.WriteBack != nil {
+		in, out := &in.WriteBack, &out.WriteBack
+		*out = new(WriteBackStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -631,6 +970,21 @@ func (in *LynqNodeStatus) DeepCopy() *LynqNodeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLEndpoint) DeepCopyInto(out *MySQLEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLEndpoint.
+func (in *MySQLEndpoint) DeepCopy() *MySQLEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MySQLSource) DeepCopyInto(out *MySQLSource) {
 	*out = *in
@@ -639,6 +993,16 @@ func (in *MySQLSource) DeepCopyInto(out *MySQLSource) {
 		*out = new(SecretRef)
 		**out = **in
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(MySQLTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make([]MySQLEndpoint, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSource.
@@ -651,6 +1015,147 @@ func (in *MySQLSource) DeepCopy() *MySQLSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLTLS) DeepCopyInto(out *MySQLTLS) {
+	*out = *in
+	if in.CARef != nil {
+		in, out := &in.CARef, &out.CARef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.ClientCertRef != nil {
+		in, out := &in.ClientCertRef, &out.ClientCertRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.ClientKeyRef != nil {
+		in, out := &in.ClientKeyRef, &out.ClientKeyRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLTLS.
+func (in *MySQLTLS) DeepCopy() *MySQLTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginSource) DeepCopyInto(out *PluginSource) {
+	*out = *in
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(MySQLTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginSource.
+func (in *PluginSource) DeepCopy() *PluginSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLSource) DeepCopyInto(out *PostgreSQLSource) {
+	*out = *in
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSource.
+func (in *PostgreSQLSource) DeepCopy() *PostgreSQLSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuarantinedRow) DeepCopyInto(out *QuarantinedRow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuarantinedRow.
+func (in *QuarantinedRow) DeepCopy() *QuarantinedRow {
+	if in == nil {
+		return nil
+	}
+	out := new(QuarantinedRow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Relation) DeepCopyInto(out *Relation) {
+	*out = *in
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Relation.
+func (in *Relation) DeepCopy() *Relation {
+	if in == nil {
+		return nil
+	}
+	out := new(Relation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RowFilter) DeepCopyInto(out *RowFilter) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]FilterCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RowFilter.
+func (in *RowFilter) DeepCopy() *RowFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(RowFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretRef) DeepCopyInto(out *SecretRef) {
 	*out = *in
@@ -666,6 +1171,60 @@ func (in *SecretRef) DeepCopy() *SecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardStatus) DeepCopyInto(out *ShardStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardStatus.
+func (in *ShardStatus) DeepCopy() *ShardStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceShard) DeepCopyInto(out *SourceShard) {
+	*out = *in
+	if in.MySQL != nil {
+		in, out := &in.MySQL, &out.MySQL
+		*out = new(MySQLSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Postgres != nil {
+		in, out := &in.Postgres, &out.Postgres
+		*out = new(PostgreSQLSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(PluginSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceShard.
+func (in *SourceShard) DeepCopy() *SourceShard {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceShard)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TResource) DeepCopyInto(out *TResource) {
 	*out = *in
@@ -714,6 +1273,11 @@ func (in *TResource) DeepCopy() *TResource {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValueMappings) DeepCopyInto(out *ValueMappings) {
 	*out = *in
+	if in.Activation != nil {
+		in, out := &in.Activation, &out.Activation
+		*out = new(ActivationRule)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValueMappings.
@@ -725,3 +1289,53 @@ func (in *ValueMappings) DeepCopy() *ValueMappings {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteBack) DeepCopyInto(out *WriteBack) {
+	*out = *in
+	out.Columns = in.Columns
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteBack.
+func (in *WriteBack) DeepCopy() *WriteBack {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteBack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteBackColumns) DeepCopyInto(out *WriteBackColumns) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteBackColumns.
+func (in *WriteBackColumns) DeepCopy() *WriteBackColumns {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteBackColumns)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteBackStatus) DeepCopyInto(out *WriteBackStatus) {
+	*out = *in
+	if in.LastWriteTime != nil {
+		in, out := &in.LastWriteTime, &out.LastWriteTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteBackStatus.
+func (in *WriteBackStatus) DeepCopy() *WriteBackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteBackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -39,6 +39,7 @@ import (
 
 	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
 	"github.com/k8s-lynq/lynq/internal/controller"
+	"github.com/k8s-lynq/lynq/internal/datasource"
 	"github.com/k8s-lynq/lynq/internal/status"
 	// +kubebuilder:scaffold:imports
 )
@@ -215,9 +216,10 @@ func main() {
 	}
 
 	if err := (&controller.LynqHubReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("lynqhub-controller"),
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("lynqhub-controller"),
+		Datasources: datasource.NewCache(),
 	}).SetupWithManager(mgr, hubConcurrency); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "LynqHub")
 		os.Exit(1)
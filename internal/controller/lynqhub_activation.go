@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
+	"github.com/k8s-lynq/lynq/internal/datasource"
+)
+
+// activationWindowLayouts are the accepted activeFrom/activeUntil formats.
+// Values without a zone are UTC.
+var activationWindowLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// activationWindow is the activeFrom/activeUntil window of a row; zero times are unbounded
+type activationWindow struct {
+	from  time.Time
+	until time.Time
+}
+
+// parseActivationWindow parses the activation window columns of a row
+func parseActivationWindow(row datasource.NodeRow) (activationWindow, error) {
+	var window activationWindow
+	var err error
+	if window.from, err = parseWindowTime(row.ActiveFrom); err != nil {
+		return window, fmt.Errorf("activeFrom: %w", err)
+	}
+	if window.until, err = parseWindowTime(row.ActiveUntil); err != nil {
+		return window, fmt.Errorf("activeUntil: %w", err)
+	}
+	return window, nil
+}
+
+// parseWindowTime parses an activation window value; an empty value is unbounded
+func parseWindowTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range activationWindowLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not an RFC 3339 timestamp or date", value)
+}
+
+// contains reports whether now is within the window (activeFrom inclusive, activeUntil exclusive)
+func (w activationWindow) contains(now time.Time) bool {
+	return (w.from.IsZero() || !now.Before(w.from)) && (w.until.IsZero() || now.Before(w.until))
+}
+
+// nextBoundary returns the first window boundary after now, or the zero time
+func (w activationWindow) nextBoundary(now time.Time) time.Time {
+	var next time.Time
+	for _, t := range []time.Time{w.from, w.until} {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+	return next
+}
+
+// rowIsActive reports whether a row is active at now: its activate value is accepted
+// and now is within its activation window
+func rowIsActive(registry *lynqv1.LynqHub, row datasource.NodeRow, now time.Time) bool {
+	if !buildActivationRule(registry.Spec.ValueMappings.Activation).IsActive(row.Activate) {
+		return false
+	}
+	window, err := parseActivationWindow(row)
+	if err != nil {
+		// Rows with invalid windows are quarantined before they are applied
+		return false
+	}
+	return window.contains(now)
+}
+
+// activationBoundaries tracks the earliest activation window boundary of the rows seen by a sync
+type activationBoundaries struct {
+	activation *datasource.ActivationRule
+	now        time.Time
+	next       time.Time
+}
+
+func newActivationBoundaries(registry *lynqv1.LynqHub, now time.Time) *activationBoundaries {
+	return &activationBoundaries{
+		activation: buildActivationRule(registry.Spec.ValueMappings.Activation),
+		now:        now,
+	}
+}
+
+// observe records the next boundary of a row. Rows whose activate value is rejected
+// never become active by time and are ignored.
+func (b *activationBoundaries) observe(row datasource.NodeRow) {
+	if !b.activation.IsActive(row.Activate) {
+		return
+	}
+	window, err := parseActivationWindow(row)
+	if err != nil {
+		return
+	}
+	if next := window.nextBoundary(b.now); !next.IsZero() && (b.next.IsZero() || next.Before(b.next)) {
+		b.next = next
+	}
+}
+
+// record stores the next boundary in the hub status. A full sync replaces it; an incremental
+// sync keeps an earlier boundary that has not passed yet.
+func (b *activationBoundaries) record(registry *lynqv1.LynqHub, fullSync bool) {
+	next := b.next
+	if previous := registry.Status.NextActivationBoundary; !fullSync && previous != nil && previous.After(b.now) &&
+		(next.IsZero() || previous.Time.Before(next)) {
+		next = previous.Time
+	}
+	if next.IsZero() {
+		registry.Status.NextActivationBoundary = nil
+		return
+	}
+	// Status times have second precision; round up so the sync never runs before the boundary
+	rounded := next.Truncate(time.Second)
+	if rounded.Before(next) {
+		rounded = rounded.Add(time.Second)
+	}
+	boundary := metav1.NewTime(rounded)
+	registry.Status.NextActivationBoundary = &boundary
+}
+
+// activationBoundaryDue reports whether the recorded activation boundary has passed
+func activationBoundaryDue(registry *lynqv1.LynqHub, now time.Time) bool {
+	boundary := registry.Status.NextActivationBoundary
+	return boundary != nil && !now.Before(boundary.Time)
+}
+
+// requeueForActivationBoundary shortens the requeue interval so that the hub syncs
+// right when the next activation boundary passes
+func requeueForActivationBoundary(interval time.Duration, registry *lynqv1.LynqHub, now time.Time) time.Duration {
+	boundary := registry.Status.NextActivationBoundary
+	if boundary == nil {
+		return interval
+	}
+	if remaining := boundary.Sub(now); remaining > 0 && remaining < interval {
+		return remaining
+	}
+	return interval
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
+	"github.com/k8s-lynq/lynq/internal/datasource"
+)
+
+// defaultFullResyncInterval is used when changeTracking.fullResyncInterval is empty or invalid
+const defaultFullResyncInterval = 10 * time.Minute
+
+// changeTrackingFingerprint identifies the hub generation and the template generations
+// a watermark was taken with. Any spec or template change forces a full sync, because
+// nodes for unchanged rows may need to be re-rendered.
+func changeTrackingFingerprint(registry *lynqv1.LynqHub, templates []*lynqv1.LynqForm) string {
+	parts := make([]string, 0, len(templates))
+	for _, tmpl := range templates {
+		parts = append(parts, fmt.Sprintf("%s:%d", tmpl.Name, tmpl.Generation))
+	}
+	sort.Strings(parts)
+
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%d", registry.Generation)
+	for _, part := range parts {
+		_, _ = fmt.Fprintf(h, "|%s", part)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// incrementalSince returns the watermark to query from, or the zero time if a full sync is needed.
+// A full sync is needed when change tracking is disabled, no watermark was recorded yet,
+// the fingerprint changed, or the full resync interval elapsed.
+func incrementalSince(registry *lynqv1.LynqHub, fingerprint string, now time.Time) time.Time {
+	tracking := registry.Spec.ChangeTracking
+	status := registry.Status.ChangeTracking
+	if tracking == nil || status == nil || status.Watermark == "" || status.LastFullSyncTime == nil {
+		return time.Time{}
+	}
+	if status.Fingerprint != fingerprint {
+		return time.Time{}
+	}
+
+	interval := defaultFullResyncInterval
+	if tracking.FullResyncInterval != "" {
+		if parsed, err := time.ParseDuration(tracking.FullResyncInterval); err == nil && parsed > 0 {
+			interval = parsed
+		}
+	}
+	if now.Sub(status.LastFullSyncTime.Time) >= interval {
+		return time.Time{}
+	}
+
+	watermark, err := time.Parse(time.RFC3339Nano, status.Watermark)
+	if err != nil {
+		return time.Time{}
+	}
+	return watermark
+}
+
+// recordFullSync updates the change tracking status after a full sync.
+// latest is the newest ChangedAt of all rows read (see latestChange).
+// The watermark is only recorded if every node was applied, otherwise the next
+// reconcile runs another full sync instead of skipping the failed rows.
+func recordFullSync(registry *lynqv1.LynqHub, latest time.Time, fingerprint string, syncFailed bool, now time.Time) {
+	if registry.Spec.ChangeTracking == nil || syncFailed {
+		registry.Status.ChangeTracking = nil
+		return
+	}
+
+	status := &lynqv1.ChangeTrackingStatus{
+		LastFullSyncTime: &metav1.Time{Time: now},
+		Fingerprint:      fingerprint,
+	}
+	// Without any row timestamp the watermark stays empty and the next sync is a full sync again.
+	// The operator clock is deliberately not used, it may be ahead of the database clock.
+	advanceWatermark(status, latest)
+	registry.Status.ChangeTracking = status
+}
+
+// latestChange returns the newest ChangedAt of rows, or latest if it is newer
+func latestChange(rows []datasource.NodeRow, latest time.Time) time.Time {
+	for _, row := range rows {
+		if row.ChangedAt.After(latest) {
+			latest = row.ChangedAt
+		}
+	}
+	return latest
+}
+
+// advanceWatermark moves the watermark to latest, never backwards
+func advanceWatermark(status *lynqv1.ChangeTrackingStatus, latest time.Time) {
+	if status == nil {
+		return
+	}
+
+	if status.Watermark != "" {
+		if parsed, err := time.Parse(time.RFC3339Nano, status.Watermark); err == nil && !latest.After(parsed) {
+			return
+		}
+	}
+	if !latest.IsZero() {
+		status.Watermark = latest.UTC().Format(time.RFC3339Nano)
+	}
+}
+
+// syncChangedRows applies an incremental sync: active changed rows are created or updated,
+// nodes of deactivated rows are deleted. Nodes of unchanged rows are not touched.
+// The deletions are checked against deletionSafety as a whole, like a full sync's garbage collection.
+// Returns the desired node count after the sync and whether the sync is incomplete because a node
+// operation failed or the deletions were blocked; the watermark must not advance past an incomplete sync.
+func (r *LynqHubReconciler) syncChangedRows(
+	ctx context.Context,
+	registry *lynqv1.LynqHub,
+	templates []*lynqv1.LynqForm,
+	rows []datasource.NodeRow,
+	existingNodes *lynqv1.LynqNodeList,
+) (int32, bool) {
+	logger := log.FromContext(ctx)
+
+	type nodeKey struct {
+		TemplateName string
+		UID          string
+	}
+	existing := make(map[nodeKey]*lynqv1.LynqNode, len(existingNodes.Items))
+	for i := range existingNodes.Items {
+		node := &existingNodes.Items[i]
+		existing[nodeKey{TemplateName: node.Spec.TemplateRef, UID: node.Spec.UID}] = node
+	}
+
+	suspend := suspendsInactiveRows(registry)
+	grace := getDeactivationGracePeriod(registry)
+	now := time.Now()
+	desiredCount := int32(len(existing))
+	syncFailed := false
+	processed := make(map[nodeKey]bool)
+	var deactivated []*lynqv1.LynqNode
+	for _, row := range rows {
+		active := rowIsActive(registry, row, now)
+		for _, tmpl := range templates {
+			key := nodeKey{TemplateName: tmpl.Name, UID: row.UID}
+			// A row returned twice is only applied once
+			if processed[key] {
+				continue
+			}
+			processed[key] = true
+			node, exists := existing[key]
+
+			// Under deactivationPolicy Suspend the node of an inactive row is kept, suspended
+			if active || suspend {
+				if !r.applyNodeRow(ctx, registry, tmpl, node, row) {
+					syncFailed = true
+				} else if !exists {
+					desiredCount++
+				}
+				continue
+			}
+
+			if !exists {
+				continue
+			}
+			if grace > 0 && r.holdDeactivatedNode(ctx, registry, node, grace, now) {
+				continue
+			}
+			deactivated = append(deactivated, node)
+		}
+	}
+
+	// Nothing is deleted while the deletions exceed the deletionSafety limit. Otherwise the
+	// blocked count of an earlier sync is kept until the next full sync recomputes it.
+	deletions, approved := r.guardDeletions(ctx, registry, deactivated, len(existing))
+	blocked := len(deactivated) > 0 && deletions == nil
+	for _, node := range deletions {
+		deleted, err := r.deleteLynqNode(ctx, registry, node,
+			"activate=false", "The row was deactivated.")
+		if err != nil {
+			syncFailed = true
+			continue
+		}
+		if deleted {
+			desiredCount--
+		}
+	}
+
+	// An approval covers a single sweep and releases the deletions blocked before
+	if approved {
+		registry.Status.BlockedDeletions = 0
+		if err := r.clearDeletionApproval(ctx, registry); err != nil {
+			logger.Error(err, "Failed to remove deletion approval annotation")
+		}
+	}
+
+	logger.V(1).Info("Incremental sync completed", "changedRows", len(rows))
+	return desiredCount, syncFailed || blocked
+}
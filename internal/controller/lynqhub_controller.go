@@ -19,11 +19,15 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	errorsStd "errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -34,17 +38,22 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
 	"github.com/k8s-lynq/lynq/internal/datasource"
 	"github.com/k8s-lynq/lynq/internal/metrics"
+	"github.com/k8s-lynq/lynq/internal/schedule"
 	"github.com/k8s-lynq/lynq/internal/template"
 )
 
 const (
 	// Finalizer for LynqHub
 	FinalizerLynqHub = "lynq.sh/hub-finalizer"
+
+	// ReasonAuthenticationFailed is the Ready condition reason of a hub whose data source rejected its credentials
+	ReasonAuthenticationFailed = "AuthenticationFailed"
 )
 
 // LynqHubReconciler reconciles a LynqHub object
@@ -52,6 +61,13 @@ type LynqHubReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+
+	// Datasources keeps datasource connection pools open between syncs, keyed by hub UID.
+	// If nil, a new connection is opened and closed for every sync.
+	Datasources *datasource.Cache
+
+	// writeBacks remembers the node statuses written back to each hub's source
+	writeBacks writeBackState
 }
 
 // +kubebuilder:rbac:groups=operator.lynq.sh,resources=lynqhubs,verbs=get;list;watch;create;update;patch;delete
@@ -59,6 +75,7 @@ type LynqHubReconciler struct {
 // +kubebuilder:rbac:groups=operator.lynq.sh,resources=lynqhubs/finalizers,verbs=update
 // +kubebuilder:rbac:groups=operator.lynq.sh,resources=lynqnodes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile syncs nodes from external data source to Kubernetes
@@ -86,6 +103,10 @@ func (r *LynqHubReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	if !registry.DeletionTimestamp.IsZero() {
 		// Registry is being deleted
 		if containsString(registry.Finalizers, FinalizerLynqHub) {
+			// Release the cached connection pool of this hub
+			r.releaseDatasource(registry)
+			r.writeBacks.forget(registry.UID)
+
 			// Run cleanup logic for DeletionPolicy.Retain resources
 			if err := r.cleanupRetainResources(ctx, registry); err != nil {
 				logger.Error(err, "Failed to cleanup retain resources")
@@ -116,23 +137,32 @@ func (r *LynqHubReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// A hub with a schedule only syncs when its schedule is due (or a sync is requested).
+	// An ended deactivation grace period also syncs, so the node is deleted on time.
+	sched := syncSchedule(ctx, registry)
+	if now := time.Now(); sched != nil && !scheduledSyncDue(registry, sched, now) {
+		nodes, err := r.getExistingLynqNodes(ctx, registry)
+		if err != nil {
+			logger.Error(err, "Failed to list existing nodes")
+			return ctrl.Result{RequeueAfter: syncInterval}, err
+		}
+		grace := getDeactivationGracePeriod(registry)
+		if grace == 0 || !pendingDeletionDue(nodes.Items, grace, now) {
+			return ctrl.Result{RequeueAfter: nextSyncAfter(syncInterval, sched, registry, nodes.Items, grace)}, nil
+		}
+	}
+
 	// Get all templates that reference this registry
 	templates, err := r.getTemplatesForRegistry(ctx, registry)
 	if err != nil {
 		logger.Error(err, "Failed to get templates for registry")
-		r.updateStatus(ctx, registry, 0, 0, 0, 0, false)
+		r.updateStatus(ctx, registry, 0, 0, 0, 0, err)
 		return ctrl.Result{RequeueAfter: syncInterval}, err
 	}
 
-	// Connect to database and query nodes
-	nodeRows, err := r.queryDatabase(ctx, registry)
-	if err != nil {
-		logger.Error(err, "Failed to query database")
-		r.Recorder.Eventf(registry, corev1.EventTypeWarning, "DatabaseQueryFailed",
-			"Failed to query database: %v", err)
-		r.updateStatus(ctx, registry, int32(len(templates)), 0, 0, 0, false)
-		return ctrl.Result{RequeueAfter: syncInterval}, err
-	}
+	// Decide between a full sync and an incremental (change tracking) sync
+	fingerprint := changeTrackingFingerprint(registry, templates)
+	since := incrementalSince(registry, fingerprint, time.Now())
 
 	// Get existing LynqNode CRs
 	existingNodes, err := r.getExistingLynqNodes(ctx, registry)
@@ -141,33 +171,55 @@ func (r *LynqHubReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{RequeueAfter: syncInterval}, err
 	}
 
-	// Build desired node set: key = {template-name}-{uid}
-	type NodeKey struct {
-		TemplateName string
-		UID          string
+	// A node whose deactivation grace period has ended is only deleted by a full sync,
+	// which confirms that its row is still inactive
+	grace := getDeactivationGracePeriod(registry)
+	if !since.IsZero() && grace > 0 && pendingDeletionDue(existingNodes.Items, grace, time.Now()) {
+		since = time.Time{}
+	}
+	// Rows crossing an activeFrom/activeUntil boundary have not changed, so incremental
+	// syncs would not see them
+	if !since.IsZero() && activationBoundaryDue(registry, time.Now()) {
+		since = time.Time{}
+	}
+	// A requested sync is a full sync
+	if !since.IsZero() && syncRequested(registry) {
+		since = time.Time{}
 	}
-	desired := make(map[NodeKey]struct {
-		Template *lynqv1.LynqForm
-		Row      datasource.NodeRow
-	})
 
-	for _, tmpl := range templates {
-		for _, row := range nodeRows {
-			key := NodeKey{
-				TemplateName: tmpl.Name,
-				UID:          row.UID,
-			}
-			desired[key] = struct {
-				Template *lynqv1.LynqForm
-				Row      datasource.NodeRow
-			}{
-				Template: tmpl,
-				Row:      row,
+	// Incremental sync: only touch nodes for changed rows, skip garbage collection
+	if !since.IsZero() {
+		var nodeRows []datasource.NodeRow
+		validator := newRowValidator()
+		boundaries := newActivationBoundaries(registry, time.Now())
+		unavailable, err := r.queryDatabase(ctx, registry, since, func(page []datasource.NodeRow) {
+			for _, row := range validator.filter(page) {
+				boundaries.observe(row)
+				nodeRows = append(nodeRows, row)
 			}
+		})
+		if err != nil {
+			return r.handleQueryFailure(ctx, registry, templates, syncInterval, err)
+		}
+		recordQuarantine(registry, validator.rows(), false)
+		boundaries.record(registry, false)
+
+		desiredCount, incomplete := r.syncChangedRows(ctx, registry, templates, nodeRows, existingNodes)
+		if !incomplete && len(unavailable) == 0 {
+			advanceWatermark(registry.Status.ChangeTracking, latestChange(nodeRows, time.Time{}))
 		}
+		readyCount, failedCount := r.countLynqNodeStatus(ctx, registry)
+		r.writeBackStatus(ctx, registry)
+		recordSync(registry, sched, time.Now())
+		r.updateStatus(ctx, registry, int32(len(templates)), desiredCount, readyCount, failedCount, nil)
+		return ctrl.Result{RequeueAfter: nextSyncAfter(syncInterval, sched, registry, existingNodes.Items, grace)}, nil
 	}
 
 	// Build existing node map: key = {template-name}-{uid}
+	type NodeKey struct {
+		TemplateName string
+		UID          string
+	}
 	existing := make(map[NodeKey]*lynqv1.LynqNode)
 	for i := range existingNodes.Items {
 		node := &existingNodes.Items[i]
@@ -178,24 +230,61 @@ func (r *LynqHubReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		existing[key] = node
 	}
 
-	// Create/update nodes for each template-row combination
-	for key, desired := range desired {
-		if existingLynqNode, exists := existing[key]; !exists {
-			// Create new LynqNode
-			if err := r.createLynqNode(ctx, registry, desired.Template, desired.Row); err != nil {
-				// Ignore AlreadyExists errors (can happen due to concurrent reconciliations)
-				if !errors.IsAlreadyExists(err) {
-					logger.Error(err, "Failed to create LynqNode", "template", key.TemplateName, "uid", key.UID)
-				}
+	// Create/update nodes for each template-row combination, one page of rows at a time.
+	// Only the keys of desired nodes are kept across pages, so memory stays bounded by the page size.
+	desired := make(map[NodeKey]struct{})
+	// uids applied by earlier pages of this sync; a row returned on two pages is only applied once
+	applied := make(map[string]bool)
+	syncFailed := false
+	rowCount := 0
+	var latestChangedAt time.Time
+	validator := newRowValidator()
+	boundaries := newActivationBoundaries(registry, time.Now())
+	suspend := suspendsInactiveRows(registry)
+	unavailable, err := r.queryDatabase(ctx, registry, since, func(page []datasource.NodeRow) {
+		// Rows with an empty, invalid or duplicate uid or an invalid activation window are skipped (quarantined)
+		for _, row := range validator.filter(page) {
+			// Rows outside their activation window are treated like inactive rows
+			boundaries.observe(row)
+			if !suspend && !rowIsActive(registry, row, boundaries.now) {
+				continue
 			}
-		} else {
-			// Update existing LynqNode if data or template changed
-			if r.shouldUpdateLynqNode(ctx, registry, existingLynqNode, desired.Row) {
-				if err := r.updateLynqNode(ctx, registry, desired.Template, existingLynqNode, desired.Row); err != nil {
-					logger.Error(err, "Failed to update LynqNode", "template", key.TemplateName, "uid", key.UID)
+			if applied[row.UID] {
+				continue
+			}
+			applied[row.UID] = true
+			rowCount++
+			for _, tmpl := range templates {
+				key := NodeKey{TemplateName: tmpl.Name, UID: row.UID}
+				desired[key] = struct{}{}
+				if !r.applyNodeRow(ctx, registry, tmpl, existing[key], row) {
+					syncFailed = true
 				}
 			}
 		}
+		latestChangedAt = latestChange(page, latestChangedAt)
+	})
+	if err != nil {
+		// Nodes of pages read before the failure were applied, but without the full
+		// desired set garbage collection must not run
+		return r.handleQueryFailure(ctx, registry, templates, syncInterval, err)
+	}
+
+	// Quarantined uids keep their existing nodes unchanged until their rows are fixed
+	quarantined := validator.rows()
+	recordQuarantine(registry, quarantined, true)
+	boundaries.record(registry, true)
+	if len(quarantined) > 0 {
+		logger.Info("Skipped rows that failed validation", "uids", len(quarantined))
+		r.Recorder.Eventf(registry, corev1.EventTypeWarning, "RowsQuarantined",
+			"%d uids were skipped because their rows failed validation; see status.quarantinedRows", len(quarantined))
+	}
+	for _, tmpl := range templates {
+		for key := range existing {
+			if key.TemplateName == tmpl.Name && validator.isQuarantined(key.UID) {
+				desired[key] = struct{}{}
+			}
+		}
 	}
 
 	// Delete nodes no longer in desired set
@@ -203,93 +292,482 @@ func (r *LynqHubReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	// 1. Rows deleted from database
 	// 2. Rows with activate=false
 	// 3. Templates deleted/changed
-	deletedCount := 0
+	// Nodes of shards that could not be read are kept until their shard is back
+	// With deactivationGracePeriod, nodes of inactive or removed rows are marked PendingDeletion first;
+	// nodes of templates that no longer reference the hub are deleted right away
+	currentTemplates := make(map[string]bool, len(templates))
+	for _, tmpl := range templates {
+		currentTemplates[tmpl.Name] = true
+	}
+	now := time.Now()
+	var stale []*lynqv1.LynqNode
+	retainedCount := 0
+	pendingCount := 0
 	for key, node := range existing {
 		if _, stillExists := desired[key]; !stillExists {
-			logger.Info("Deleting LynqNode (no longer in desired set)",
-				"node", node.Name,
-				"template", key.TemplateName,
-				"uid", key.UID,
-				"reason", "row removed from database or activate=false or template changed")
-
-			// Emit detailed deletion event
-			r.Recorder.Eventf(registry, corev1.EventTypeNormal, "NodeDeleting",
-				"Deleting LynqNode '%s' (template: %s, uid: %s) - no longer in active dataset. "+
-					"This could be due to: row deletion, activate=false, or template change.",
-				node.Name, key.TemplateName, key.UID)
-
-			if err := r.Delete(ctx, node); err != nil {
-				if !errors.IsNotFound(err) {
-					logger.Error(err, "Failed to delete LynqNode", "node", node.Name, "template", key.TemplateName, "uid", key.UID)
-					r.Recorder.Eventf(registry, corev1.EventTypeWarning, "NodeDeletionFailed",
-						"Failed to delete LynqNode '%s': %v", node.Name, err)
-				}
-			} else {
-				deletedCount++
-				r.Recorder.Eventf(registry, corev1.EventTypeNormal, "NodeDeleted",
-					"Successfully deleted LynqNode '%s' (template: %s, uid: %s)",
-					node.Name, key.TemplateName, key.UID)
+			if shardUnavailable(node, unavailable) {
+				retainedCount++
+				continue
 			}
+			if grace > 0 && currentTemplates[key.TemplateName] && r.holdDeactivatedNode(ctx, registry, node, grace, now) {
+				pendingCount++
+				continue
+			}
+			stale = append(stale, node)
+		}
+	}
+
+	// deletionSafety blocks an unexpectedly large sweep (e.g. an empty or truncated source)
+	// until it is approved with the lynq.sh/allow-deletions annotation
+	registry.Status.BlockedDeletions = 0
+	stale, approved := r.guardDeletions(ctx, registry, stale, len(existing))
+
+	deletedCount := 0
+	for _, node := range stale {
+		deleted, err := r.deleteLynqNode(ctx, registry, node,
+			"row removed from database or activate=false or template changed",
+			"This could be due to: row deletion, activate=false, or template change.")
+		if err != nil {
+			syncFailed = true
+		}
+		if deleted {
+			deletedCount++
+		}
+	}
+
+	// An approval covers a single sweep over the limit; a sweep within the limit keeps it
+	if approved {
+		if err := r.clearDeletionApproval(ctx, registry); err != nil {
+			logger.Error(err, "Failed to remove deletion approval annotation")
 		}
 	}
 
 	if deletedCount > 0 {
 		logger.Info("Garbage collection completed", "deletedNodes", deletedCount)
 	}
+	if retainedCount > 0 {
+		logger.Info("Kept nodes of unavailable shards", "nodes", retainedCount, "shards", len(unavailable))
+	}
+	if pendingCount > 0 {
+		logger.Info("Kept nodes within the deactivation grace period", "nodes", pendingCount, "gracePeriod", grace)
+	}
+
+	// Record the change tracking watermark of this full sync
+	recordFullSync(registry, latestChangedAt, fingerprint, syncFailed, time.Now())
 
 	// Update status
 	readyCount, failedCount := r.countLynqNodeStatus(ctx, registry)
-	totalDesired := int32(len(templates)) * int32(len(nodeRows))
-	r.updateStatus(ctx, registry, int32(len(templates)), totalDesired, readyCount, failedCount, true)
+	totalDesired := int32(len(templates)) * int32(rowCount)
+	r.writeBackStatus(ctx, registry)
+	recordSync(registry, sched, time.Now())
+	r.updateStatus(ctx, registry, int32(len(templates)), totalDesired, readyCount, failedCount, nil)
+
+	// A sync request covers a single sync
+	if syncRequested(registry) {
+		if err := r.clearSyncRequest(ctx, registry); err != nil {
+			logger.Error(err, "Failed to remove sync request annotation")
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: nextSyncAfter(syncInterval, sched, registry, existingNodes.Items, grace)}, nil
+}
+
+// nextSyncAfter returns the requeue interval of a synced hub: the sync interval (or the time until
+// the next scheduled sync), shortened to the end of the next deactivation grace period or activation
+// window boundary
+func nextSyncAfter(
+	syncInterval time.Duration,
+	sched *schedule.Schedule,
+	registry *lynqv1.LynqHub,
+	nodes []lynqv1.LynqNode,
+	grace time.Duration,
+) time.Duration {
+	now := time.Now()
+	interval := syncInterval
+	if sched != nil {
+		interval = untilScheduledSync(sched, syncInterval, now)
+	}
+	return requeueForActivationBoundary(requeueForPendingDeletion(interval, nodes, grace, now), registry, now)
+}
+
+// handleQueryFailure reports a failed database query on the hub and requeues it
+func (r *LynqHubReconciler) handleQueryFailure(
+	ctx context.Context,
+	registry *lynqv1.LynqHub,
+	templates []*lynqv1.LynqForm,
+	syncInterval time.Duration,
+	err error,
+) (ctrl.Result, error) {
+	log.FromContext(ctx).Error(err, "Failed to query database")
+	r.updateStatus(ctx, registry, int32(len(templates)), 0, 0, 0, err)
+
+	// Retrying rejected credentials cannot succeed and may lock the database account, so instead of
+	// backing off the hub waits for the next sync or an edit of its Secrets, which triggers a sync
+	var authErr *datasource.AuthError
+	if errorsStd.As(err, &authErr) {
+		r.Recorder.Eventf(registry, corev1.EventTypeWarning, ReasonAuthenticationFailed,
+			"Data source rejected the credentials: %v", err)
+		return ctrl.Result{RequeueAfter: syncInterval}, nil
+	}
+	r.Recorder.Eventf(registry, corev1.EventTypeWarning, "DatabaseQueryFailed",
+		"Failed to query database: %v", err)
+	return ctrl.Result{RequeueAfter: syncInterval}, err
+}
 
-	return ctrl.Result{RequeueAfter: syncInterval}, nil
+// queryDatabase connects to database and passes the node rows to handlePage, one page at a time
+// (a single page unless spec.source.pageSize is set).
+// A non-zero since restricts the query to rows changed at or after it (incremental sync).
+// Federated hubs (spec.source.shards) return the names of the shards that could not be read;
+// their rows are missing from the pages.
+func (r *LynqHubReconciler) queryDatabase(
+	ctx context.Context,
+	registry *lynqv1.LynqHub,
+	since time.Time,
+	handlePage func([]datasource.NodeRow),
+) (map[string]bool, error) {
+	if len(registry.Spec.Source.Shards) > 0 {
+		return r.queryShards(ctx, registry, since, handlePage)
+	}
+
+	registry.Status.Shards = nil
+	key := string(registry.UID)
+	if r.Datasources != nil {
+		// Close the pools of shards this hub no longer uses
+		_ = r.Datasources.Prune(key, map[string]bool{key: true})
+	}
+	return nil, r.querySource(ctx, registry, key, since, handlePage)
 }
 
-// queryDatabase connects to database and retrieves node rows
-func (r *LynqHubReconciler) queryDatabase(ctx context.Context, registry *lynqv1.LynqHub) ([]datasource.NodeRow, error) {
+// querySource reads the rows of the source of registry, caching its datasource under cacheKey
+func (r *LynqHubReconciler) querySource(
+	ctx context.Context,
+	registry *lynqv1.LynqHub,
+	cacheKey string,
+	since time.Time,
+	handlePage func([]datasource.NodeRow),
+) error {
+	ds, table, release, err := r.openSource(ctx, registry, cacheKey)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// Query nodes
+	extraMappings, extraTypes := buildExtraMappings(registry)
+	queryConfig := datasource.QueryConfig{
+		Table: table,
+		Query: getSourceQuery(registry),
+		ValueMappings: datasource.ValueMappings{
+			UID:         registry.Spec.ValueMappings.UID,
+			HostOrURL:   registry.Spec.ValueMappings.HostOrURL,
+			Activate:    registry.Spec.ValueMappings.Activate,
+			Activation:  buildActivationRule(registry.Spec.ValueMappings.Activation),
+			ActiveFrom:  registry.Spec.ValueMappings.ActiveFrom,
+			ActiveUntil: registry.Spec.ValueMappings.ActiveUntil,
+		},
+		ExtraMappings: extraMappings,
+		ExtraTypes:    extraTypes,
+		ExtraPaths:    buildExtraPaths(registry),
+		Relations:     buildRelations(registry.Spec.Relations),
+		Filters:       buildFilterConditions(registry.Spec.Filter),
+		PageSize:      int(registry.Spec.Source.PageSize),
+	}
+	if tracking := registry.Spec.ChangeTracking; tracking != nil {
+		queryConfig.ChangeTracking = &datasource.ChangeTracking{
+			Column: tracking.Column,
+			Since:  since,
+		}
+		// Incremental syncs need inactive rows to remove deactivated nodes
+		queryConfig.IncludeInactive = !since.IsZero()
+	}
+	if suspendsInactiveRows(registry) {
+		// Inactive rows keep their nodes, suspended
+		queryConfig.IncludeInactive = true
+	}
+
+	// Read rows page by page (a single page unless pageSize is set)
+	queryTimeout := getQueryTimeout(registry)
+	after := ""
+	var extraErrors []string
+	for {
+		rows, next, err := queryPage(ctx, ds, queryConfig, after, queryTimeout)
+		if cacheKey == string(registry.UID) {
+			// Pool metrics are per hub, so shard pools are not exported
+			recordPoolStats(registry, ds)
+		}
+		if err != nil {
+			if r.Datasources != nil {
+				// Recycle the pool so the next sync starts with fresh connections
+				_ = r.Datasources.Invalidate(cacheKey)
+			}
+			return err
+		}
+
+		extraErrors = append(extraErrors, extraValueErrors(rows)...)
+		handlePage(rows)
+		if next == "" {
+			break
+		}
+		after = next
+	}
+	r.recordServingEndpoint(registry, ds)
+
+	// Rows with unparsable extra values are synced with empty values; report them on the hub
+	if len(extraErrors) > 0 {
+		log.FromContext(ctx).Info("Some extra values could not be extracted", "count", len(extraErrors), "errors", extraErrors)
+		shown := extraErrors
+		if len(shown) > 5 {
+			shown = append(shown[:5:5], fmt.Sprintf("and %d more", len(extraErrors)-5))
+		}
+		r.Recorder.Eventf(registry, corev1.EventTypeWarning, "ExtraValueParseFailed",
+			"%d extra values could not be extracted and were left empty: %s", len(extraErrors), strings.Join(shown, "; "))
+	}
+	return nil
+}
+
+// openSource returns the datasource of the source of registry, cached under cacheKey, and its table.
+// release closes the datasource unless it is cached.
+func (r *LynqHubReconciler) openSource(
+	ctx context.Context,
+	registry *lynqv1.LynqHub,
+	cacheKey string,
+) (datasource.Datasource, string, func(), error) {
 	// Determine datasource type
 	sourceType := datasource.SourceType(registry.Spec.Source.Type)
 
 	// Get password from Secret (MySQL/PostgreSQL specific)
 	password := ""
-	if registry.Spec.Source.MySQL != nil && registry.Spec.Source.MySQL.PasswordRef != nil {
+	if passwordRef := getPasswordRef(registry); passwordRef != nil {
 		secret := &corev1.Secret{}
 		if err := r.Get(ctx, types.NamespacedName{
-			Name:      registry.Spec.Source.MySQL.PasswordRef.Name,
+			Name:      passwordRef.Name,
 			Namespace: registry.Namespace,
 		}, secret); err != nil {
-			return nil, fmt.Errorf("failed to get password secret: %w", err)
+			return nil, "", nil, fmt.Errorf("failed to get password secret: %w", err)
 		}
-		password = string(secret.Data[registry.Spec.Source.MySQL.PasswordRef.Key])
+		password = string(secret.Data[passwordRef.Key])
 	}
 
 	// Build datasource config
 	config, table, err := r.buildDatasourceConfig(registry, password)
 	if err != nil {
-		return nil, err
+		return nil, "", nil, err
+	}
+
+	// Load TLS material from Secrets (MySQL and plugin specific)
+	if tlsSpec := getTLSSpec(registry); tlsSpec != nil {
+		config.TLS, err = r.loadTLSConfig(ctx, registry.Namespace, tlsSpec)
+		if err != nil {
+			return nil, "", nil, err
+		}
+	}
+
+	// Load rows from a ConfigMap or Secret (Kubernetes specific)
+	if src := registry.Spec.Source.Kubernetes; registry.Spec.Source.Type == lynqv1.SourceTypeKubernetes && len(src.Rows) == 0 {
+		config.Kubernetes.Payload, err = r.loadKubernetesRows(ctx, registry.Namespace, src)
+		if err != nil {
+			return nil, "", nil, err
+		}
 	}
 
-	// Create datasource adapter
+	// Get a datasource adapter, reusing the hub's cached connection pool if its config is unchanged
+	if r.Datasources != nil {
+		ds, err := r.Datasources.Get(cacheKey, sourceType, config)
+		if err != nil {
+			return nil, "", nil, fmt.Errorf("failed to create datasource: %w", err)
+		}
+		return ds, table, func() {}, nil
+	}
 	ds, err := datasource.NewDatasource(sourceType, config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create datasource: %w", err)
+		return nil, "", nil, fmt.Errorf("failed to create datasource: %w", err)
 	}
-	defer func() {
+	return ds, table, func() {
 		_ = ds.Close() // Best effort close
-	}()
+	}, nil
+}
 
-	// Query nodes
-	queryConfig := datasource.QueryConfig{
-		Table: table,
-		ValueMappings: datasource.ValueMappings{
-			UID:       registry.Spec.ValueMappings.UID,
-			HostOrURL: registry.Spec.ValueMappings.HostOrURL,
-			Activate:  registry.Spec.ValueMappings.Activate,
-		},
-		ExtraMappings: registry.Spec.ExtraValueMappings,
+// extraValueErrors returns "uid/key: error" for every extra value of rows that could not be extracted
+func extraValueErrors(rows []datasource.NodeRow) []string {
+	var errs []string
+	for _, row := range rows {
+		keys := make([]string, 0, len(row.ExtraErrors))
+		for key := range row.ExtraErrors {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			errs = append(errs, fmt.Sprintf("%s/%s: %s", row.UID, key, row.ExtraErrors[key]))
+		}
 	}
+	return errs
+}
+
+// queryPage reads one page of rows. Each page is bounded by the query timeout
+// so a slow table scan cannot block the hub worker.
+func queryPage(
+	ctx context.Context,
+	ds datasource.Datasource,
+	config datasource.QueryConfig,
+	after string,
+	timeout time.Duration,
+) ([]datasource.NodeRow, string, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	rows, next, err := datasource.QueryPage(queryCtx, ds, config, after)
+	if err != nil && errorsStd.Is(queryCtx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("query timed out after %s: %w", timeout, context.DeadlineExceeded)
+	}
+	return rows, next, err
+}
 
-	return ds.QueryNodes(ctx, queryConfig)
+// recordPoolStats exports the connection pool statistics of a datasource as metrics
+func recordPoolStats(registry *lynqv1.LynqHub, ds datasource.Datasource) {
+	provider, ok := ds.(datasource.PoolStatsProvider)
+	if !ok {
+		return
+	}
+	stats := provider.PoolStats()
+	metrics.RegistryDatasourceConnections.WithLabelValues(registry.Name, registry.Namespace, "open").Set(float64(stats.OpenConnections))
+	metrics.RegistryDatasourceConnections.WithLabelValues(registry.Name, registry.Namespace, "in_use").Set(float64(stats.InUse))
+	metrics.RegistryDatasourceConnections.WithLabelValues(registry.Name, registry.Namespace, "idle").Set(float64(stats.Idle))
+	metrics.RegistryDatasourceMaxOpenConnections.WithLabelValues(registry.Name, registry.Namespace).Set(float64(stats.MaxOpenConnections))
+	metrics.RegistryDatasourceWaitCount.WithLabelValues(registry.Name, registry.Namespace).Set(float64(stats.WaitCount))
+	metrics.RegistryDatasourceWaitDuration.WithLabelValues(registry.Name, registry.Namespace).Set(stats.WaitDuration.Seconds())
+}
+
+// releaseDatasource closes the cached connection pool of a hub and drops its pool metrics
+func (r *LynqHubReconciler) releaseDatasource(registry *lynqv1.LynqHub) {
+	if r.Datasources != nil {
+		_ = r.Datasources.Prune(string(registry.UID), nil) // Best effort close, including shards
+	}
+	for _, state := range []string{"open", "in_use", "idle"} {
+		metrics.RegistryDatasourceConnections.DeleteLabelValues(registry.Name, registry.Namespace, state)
+	}
+	metrics.RegistryDatasourceMaxOpenConnections.DeleteLabelValues(registry.Name, registry.Namespace)
+	metrics.RegistryDatasourceWaitCount.DeleteLabelValues(registry.Name, registry.Namespace)
+	metrics.RegistryDatasourceWaitDuration.DeleteLabelValues(registry.Name, registry.Namespace)
+}
+
+// buildExtraMappings returns the key -> column mappings of extraValueMappings
+// and the key -> type overrides of extraValueTypes
+func buildExtraMappings(registry *lynqv1.LynqHub) (map[string]string, map[string]datasource.ValueType) {
+	if len(registry.Spec.ExtraValueMappings) == 0 {
+		return nil, nil
+	}
+
+	var types map[string]datasource.ValueType
+	for key, valueType := range registry.Spec.ExtraValueTypes {
+		if _, mapped := registry.Spec.ExtraValueMappings[key]; !mapped || valueType.Type == lynqv1.ValueTypeString {
+			continue
+		}
+		if types == nil {
+			types = make(map[string]datasource.ValueType)
+		}
+		if valueType.Type == "" {
+			types[key] = datasource.ValueTypeAuto
+		} else {
+			types[key] = datasource.ValueType(valueType.Type)
+		}
+	}
+	return registry.Spec.ExtraValueMappings, types
+}
+
+// buildExtraPaths returns the JSONPaths of the extra mappings that select a nested value
+func buildExtraPaths(registry *lynqv1.LynqHub) map[string]string {
+	var paths map[string]string
+	for key, valueType := range registry.Spec.ExtraValueTypes {
+		if _, mapped := registry.Spec.ExtraValueMappings[key]; !mapped || valueType.Path == "" {
+			continue
+		}
+		if paths == nil {
+			paths = make(map[string]string)
+		}
+		paths[key] = valueType.Path
+	}
+	return paths
+}
+
+// buildRelations converts the hub relations into datasource relations
+func buildRelations(relations map[string]lynqv1.Relation) map[string]datasource.Relation {
+	if len(relations) == 0 {
+		return nil
+	}
+	result := make(map[string]datasource.Relation, len(relations))
+	for key, relation := range relations {
+		result[key] = datasource.Relation{
+			Table:      relation.Table,
+			ForeignKey: relation.ForeignKey,
+			Columns:    relation.Columns,
+			OrderBy:    relation.OrderBy,
+		}
+	}
+	return result
+}
+
+// buildActivationRule converts the API activation rule to a datasource rule (nil keeps the default truthy values)
+func buildActivationRule(rule *lynqv1.ActivationRule) *datasource.ActivationRule {
+	if rule == nil {
+		return nil
+	}
+	return &datasource.ActivationRule{
+		Values:          rule.Values,
+		Negate:          rule.Operator == lynqv1.ActivationOperatorNotIn,
+		CaseInsensitive: rule.CaseInsensitive,
+	}
+}
+
+// extraTypesAnnotation returns the lynq.sh/extra-types annotation value for a row,
+// or "" if all extra values are plain strings
+func extraTypesAnnotation(row datasource.NodeRow) string {
+	if len(row.ExtraTypes) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(row.ExtraTypes)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// extraTypeNames converts the resolved extra types of a row for template.BuildVariables
+func extraTypeNames(types map[string]datasource.ValueType) map[string]string {
+	if len(types) == 0 {
+		return nil
+	}
+	names := make(map[string]string, len(types))
+	for key, valueType := range types {
+		names[key] = string(valueType)
+	}
+	return names
+}
+
+// buildFilterConditions converts the hub row filter into datasource filter conditions
+func buildFilterConditions(filter *lynqv1.RowFilter) []datasource.FilterCondition {
+	if filter == nil {
+		return nil
+	}
+
+	conditions := make([]datasource.FilterCondition, 0, len(filter.Conditions))
+	for _, cond := range filter.Conditions {
+		var values []string
+		switch cond.Operator {
+		case lynqv1.FilterOperatorIsNull, lynqv1.FilterOperatorIsNotNull:
+			// No operands
+		case lynqv1.FilterOperatorIn, lynqv1.FilterOperatorNotIn:
+			values = cond.Values
+		default:
+			// Single operand; an empty value compares against the empty string
+			values = []string{cond.Value}
+		}
+		conditions = append(conditions, datasource.FilterCondition{
+			Column:   cond.Column,
+			Operator: datasource.FilterOperator(cond.Operator),
+			Values:   values,
+		})
+	}
+	return conditions
 }
 
 // buildDatasourceConfig builds datasource configuration from LynqHub spec
@@ -308,14 +786,278 @@ func (r *LynqHubReconciler) buildDatasourceConfig(registry *lynqv1.LynqHub, pass
 			Password: password,
 			Database: mysql.Database,
 		}
+		for _, replica := range mysql.Replicas {
+			port := replica.Port
+			if port == 0 {
+				port = 3306
+			}
+			config.Replicas = append(config.Replicas, datasource.Endpoint{Host: replica.Host, Port: port})
+		}
 
-		return config, mysql.Table, nil
+		return applyConnectionSettings(config, registry.Spec.Source.Connection), mysql.Table, nil
+
+	case lynqv1.SourceTypePostgreSQL:
+		pg := registry.Spec.Source.Postgres
+		if pg == nil {
+			return datasource.Config{}, "", fmt.Errorf("PostgreSQL configuration is nil")
+		}
+
+		config := datasource.Config{
+			Host:     pg.Host,
+			Port:     pg.Port,
+			Username: pg.Username,
+			Password: password,
+			Database: pg.Database,
+			Schema:   pg.Schema,
+			SSLMode:  string(pg.SSLMode),
+		}
+
+		return applyConnectionSettings(config, registry.Spec.Source.Connection), pg.Table, nil
+
+	case lynqv1.SourceTypeHTTP:
+		src := registry.Spec.Source.HTTP
+		if src == nil {
+			return datasource.Config{}, "", fmt.Errorf("HTTP configuration is nil")
+		}
+
+		httpConfig := &datasource.HTTPConfig{
+			URL:      src.URL,
+			Headers:  src.Headers,
+			RowsPath: src.RowsPath,
+		}
+		config := datasource.Config{Password: password, HTTP: httpConfig}
+		if src.Auth != nil {
+			httpConfig.AuthType = string(src.Auth.Type)
+			config.Username = src.Auth.Username
+		}
+		if p := src.Pagination; p != nil {
+			httpConfig.Pagination = &datasource.HTTPPagination{
+				Type:         string(p.Type),
+				NextLinkPath: p.NextLinkPath,
+				CursorPath:   p.CursorPath,
+				CursorParam:  p.CursorParam,
+				MaxPages:     int(p.MaxPages),
+			}
+		}
+
+		return applyConnectionSettings(config, registry.Spec.Source.Connection), "", nil
+
+	case lynqv1.SourceTypeKubernetes:
+		src := registry.Spec.Source.Kubernetes
+		if src == nil {
+			return datasource.Config{}, "", fmt.Errorf("kubernetes configuration is nil")
+		}
+
+		// ConfigMap and Secret payloads are loaded by loadKubernetesRows
+		k8sConfig := &datasource.KubernetesConfig{Format: string(src.Format)}
+		if len(src.Rows) > 0 {
+			payload, err := json.Marshal(src.Rows)
+			if err != nil {
+				return datasource.Config{}, "", fmt.Errorf("failed to encode inline rows: %w", err)
+			}
+			k8sConfig.Format = datasource.KubernetesFormatJSON
+			k8sConfig.Payload = payload
+		}
+
+		return datasource.Config{Kubernetes: k8sConfig}, "", nil
+
+	case lynqv1.SourceTypePlugin:
+		plugin := registry.Spec.Source.Plugin
+		if plugin == nil {
+			return datasource.Config{}, "", fmt.Errorf("plugin configuration is nil")
+		}
+
+		config := datasource.Config{
+			Password: password,
+			Plugin: &datasource.PluginConfig{
+				Endpoint: plugin.Endpoint,
+				Options:  plugin.Options,
+			},
+		}
+
+		return applyConnectionSettings(config, registry.Spec.Source.Connection), "", nil
 
 	default:
 		return datasource.Config{}, "", fmt.Errorf("unsupported source type: %s", registry.Spec.Source.Type)
 	}
 }
 
+// applyConnectionSettings copies pool sizes and connect timeout from source.connection into config
+func applyConnectionSettings(config datasource.Config, conn *lynqv1.ConnectionSettings) datasource.Config {
+	if conn == nil {
+		return config
+	}
+	config.MaxOpenConns = int(conn.MaxOpenConns)
+	config.MaxIdleConns = int(conn.MaxIdleConns)
+	config.ConnMaxLifetime = conn.ConnMaxLifetime
+	config.ConnectTimeout = conn.ConnectTimeout
+	return config
+}
+
+// defaultQueryTimeout bounds a sync query when source.connection.queryTimeout is not set
+const defaultQueryTimeout = time.Minute
+
+// getQueryTimeout returns the query timeout of a hub
+func getQueryTimeout(registry *lynqv1.LynqHub) time.Duration {
+	conn := registry.Spec.Source.Connection
+	if conn == nil || conn.QueryTimeout == "" {
+		return defaultQueryTimeout
+	}
+	timeout, err := time.ParseDuration(conn.QueryTimeout)
+	if err != nil || timeout <= 0 {
+		return defaultQueryTimeout
+	}
+	return timeout
+}
+
+// loadTLSConfig reads the CA bundle and client certificate referenced by a TLS spec
+func (r *LynqHubReconciler) loadTLSConfig(ctx context.Context, namespace string, spec *lynqv1.MySQLTLS) (*datasource.TLSConfig, error) {
+	config := &datasource.TLSConfig{
+		ServerName:         spec.ServerName,
+		InsecureSkipVerify: spec.InsecureSkipVerify,
+	}
+
+	for _, item := range []struct {
+		name string
+		ref  *lynqv1.SecretRef
+		dest *[]byte
+	}{
+		{name: "CA", ref: spec.CARef, dest: &config.CA},
+		{name: "client certificate", ref: spec.ClientCertRef, dest: &config.ClientCert},
+		{name: "client key", ref: spec.ClientKeyRef, dest: &config.ClientKey},
+	} {
+		if item.ref == nil {
+			continue
+		}
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: item.ref.Name, Namespace: namespace}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get TLS %s secret: %w", item.name, err)
+		}
+		data, ok := secret.Data[item.ref.Key]
+		if !ok || len(data) == 0 {
+			return nil, fmt.Errorf("TLS %s secret %s has no key %q", item.name, item.ref.Name, item.ref.Key)
+		}
+		*item.dest = data
+	}
+
+	return config, nil
+}
+
+// loadKubernetesRows reads the row payload referenced by a kubernetes source
+func (r *LynqHubReconciler) loadKubernetesRows(ctx context.Context, namespace string, src *lynqv1.KubernetesSource) ([]byte, error) {
+	switch {
+	case src.ConfigMapRef != nil:
+		cm := &corev1.ConfigMap{}
+		if err := r.Get(ctx, types.NamespacedName{Name: src.ConfigMapRef.Name, Namespace: namespace}, cm); err != nil {
+			return nil, fmt.Errorf("failed to get rows configmap: %w", err)
+		}
+		if data, ok := cm.Data[src.ConfigMapRef.Key]; ok {
+			return []byte(data), nil
+		}
+		if data, ok := cm.BinaryData[src.ConfigMapRef.Key]; ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf("rows configmap %s has no key %q", src.ConfigMapRef.Name, src.ConfigMapRef.Key)
+
+	case src.SecretRef != nil:
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: src.SecretRef.Name, Namespace: namespace}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get rows secret: %w", err)
+		}
+		data, ok := secret.Data[src.SecretRef.Key]
+		if !ok {
+			return nil, fmt.Errorf("rows secret %s has no key %q", src.SecretRef.Name, src.SecretRef.Key)
+		}
+		return data, nil
+
+	default:
+		return nil, fmt.Errorf("kubernetes source requires configMapRef, secretRef or rows")
+	}
+}
+
+// getPasswordRef returns the password Secret reference of the configured source, if any
+func getPasswordRef(registry *lynqv1.LynqHub) *lynqv1.SecretRef {
+	switch registry.Spec.Source.Type {
+	case lynqv1.SourceTypeMySQL:
+		if registry.Spec.Source.MySQL != nil {
+			return registry.Spec.Source.MySQL.PasswordRef
+		}
+	case lynqv1.SourceTypePostgreSQL:
+		if registry.Spec.Source.Postgres != nil {
+			return registry.Spec.Source.Postgres.PasswordRef
+		}
+	case lynqv1.SourceTypeHTTP:
+		// The bearer token or basic auth password
+		if registry.Spec.Source.HTTP != nil && registry.Spec.Source.HTTP.Auth != nil {
+			return &registry.Spec.Source.HTTP.Auth.SecretRef
+		}
+	case lynqv1.SourceTypePlugin:
+		// The bearer token
+		if registry.Spec.Source.Plugin != nil {
+			return registry.Spec.Source.Plugin.TokenRef
+		}
+	}
+	return nil
+}
+
+// referencedSecrets returns the names of the Secrets the source of registry reads: passwords and
+// tokens, TLS material and the rows of kubernetes sources, of the source and of every shard
+func referencedSecrets(registry *lynqv1.LynqHub) map[string]bool {
+	names := make(map[string]bool)
+	add := func(ref *lynqv1.SecretRef) {
+		if ref != nil && ref.Name != "" {
+			names[ref.Name] = true
+		}
+	}
+
+	sources := []*lynqv1.LynqHub{registry}
+	for _, shard := range registry.Spec.Source.Shards {
+		sources = append(sources, shardHub(registry, shard))
+	}
+	for _, hub := range sources {
+		add(getPasswordRef(hub))
+		if tlsSpec := getTLSSpec(hub); tlsSpec != nil {
+			add(tlsSpec.CARef)
+			add(tlsSpec.ClientCertRef)
+			add(tlsSpec.ClientKeyRef)
+		}
+	}
+	if src := registry.Spec.Source.Kubernetes; registry.Spec.Source.Type == lynqv1.SourceTypeKubernetes && src != nil {
+		add(src.SecretRef)
+	}
+	return names
+}
+
+// getTLSSpec returns the TLS settings of the configured source, if any
+func getTLSSpec(registry *lynqv1.LynqHub) *lynqv1.MySQLTLS {
+	switch registry.Spec.Source.Type {
+	case lynqv1.SourceTypeMySQL:
+		if registry.Spec.Source.MySQL != nil {
+			return registry.Spec.Source.MySQL.TLS
+		}
+	case lynqv1.SourceTypePlugin:
+		if registry.Spec.Source.Plugin != nil {
+			return registry.Spec.Source.Plugin.TLS
+		}
+	}
+	return nil
+}
+
+// getSourceQuery returns the custom read-only query of the configured source, if any
+func getSourceQuery(registry *lynqv1.LynqHub) string {
+	switch registry.Spec.Source.Type {
+	case lynqv1.SourceTypeMySQL:
+		if registry.Spec.Source.MySQL != nil {
+			return registry.Spec.Source.MySQL.Query
+		}
+	case lynqv1.SourceTypePostgreSQL:
+		if registry.Spec.Source.Postgres != nil {
+			return registry.Spec.Source.Postgres.Query
+		}
+	}
+	return ""
+}
+
 // getTemplatesForRegistry retrieves all LynqForms that reference this registry
 func (r *LynqHubReconciler) getTemplatesForRegistry(ctx context.Context, registry *lynqv1.LynqHub) ([]*lynqv1.LynqForm, error) {
 	// List all templates in the same namespace
@@ -525,12 +1267,78 @@ func (r *LynqHubReconciler) renderResource(
 	return rendered, nil
 }
 
+// applyNodeRow creates the LynqNode for a row, or updates the existing one if its data or template changed.
+// Returns false if the create or update failed.
+func (r *LynqHubReconciler) applyNodeRow(ctx context.Context, registry *lynqv1.LynqHub, tmpl *lynqv1.LynqForm, existing *lynqv1.LynqNode, row datasource.NodeRow) bool {
+	logger := log.FromContext(ctx)
+
+	if existing == nil {
+		// Create new LynqNode
+		if err := r.createLynqNode(ctx, registry, tmpl, row); err != nil {
+			// Ignore AlreadyExists errors (can happen due to concurrent reconciliations)
+			if !errors.IsAlreadyExists(err) {
+				logger.Error(err, "Failed to create LynqNode", "template", tmpl.Name, "uid", row.UID)
+				return false
+			}
+		}
+		return true
+	}
+
+	// A row that is active again keeps its node
+	if err := r.cancelPendingDeletion(ctx, registry, existing); err != nil {
+		logger.Error(err, "Failed to cancel pending deletion of LynqNode", "template", tmpl.Name, "uid", row.UID)
+		return false
+	}
+
+	// Update existing LynqNode if data or template changed
+	if r.shouldUpdateLynqNode(ctx, registry, existing, row) {
+		if err := r.updateLynqNode(ctx, registry, tmpl, existing, row); err != nil {
+			logger.Error(err, "Failed to update LynqNode", "template", tmpl.Name, "uid", row.UID)
+			return false
+		}
+	}
+	return true
+}
+
+// deleteLynqNode deletes a LynqNode that is no longer desired and emits deletion events.
+// reason is logged, detail is appended to the NodeDeleting event message.
+// Returns true if the node was deleted; a NotFound error is not reported.
+func (r *LynqHubReconciler) deleteLynqNode(ctx context.Context, registry *lynqv1.LynqHub, node *lynqv1.LynqNode, reason, detail string) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	logger.Info("Deleting LynqNode (no longer in desired set)",
+		"node", node.Name,
+		"template", node.Spec.TemplateRef,
+		"uid", node.Spec.UID,
+		"reason", reason)
+
+	// Emit detailed deletion event
+	r.Recorder.Eventf(registry, corev1.EventTypeNormal, "NodeDeleting",
+		"Deleting LynqNode '%s' (template: %s, uid: %s) - no longer in active dataset. %s",
+		node.Name, node.Spec.TemplateRef, node.Spec.UID, detail)
+
+	if err := r.Delete(ctx, node); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		logger.Error(err, "Failed to delete LynqNode", "node", node.Name, "template", node.Spec.TemplateRef, "uid", node.Spec.UID)
+		r.Recorder.Eventf(registry, corev1.EventTypeWarning, "NodeDeletionFailed",
+			"Failed to delete LynqNode '%s': %v", node.Name, err)
+		return false, err
+	}
+
+	r.Recorder.Eventf(registry, corev1.EventTypeNormal, "NodeDeleted",
+		"Successfully deleted LynqNode '%s' (template: %s, uid: %s)",
+		node.Name, node.Spec.TemplateRef, node.Spec.UID)
+	return true, nil
+}
+
 // createLynqNode creates a new LynqNode CR
 func (r *LynqHubReconciler) createLynqNode(ctx context.Context, registry *lynqv1.LynqHub, tmpl *lynqv1.LynqForm, row datasource.NodeRow) error {
 	logger := log.FromContext(ctx)
 
 	// 1. Build template variables
-	vars := template.BuildVariables(row.UID, row.HostOrURL, row.Activate, row.Extra)
+	vars := buildRowVariables(registry, row)
 
 	// 2. Render all template resources
 	renderedSpec, err := r.renderAllTemplateResources(tmpl, vars)
@@ -567,6 +1375,15 @@ func (r *LynqHubReconciler) createLynqNode(ctx context.Context, registry *lynqv1
 		},
 		Spec: *renderedSpec,
 	}
+	if extraTypes := extraTypesAnnotation(row); extraTypes != "" {
+		node.Annotations[AnnotationExtraTypes] = extraTypes
+	}
+	if row.Shard != "" {
+		node.Labels[LabelShard] = row.Shard
+	}
+	if rowSuspended(registry, row) {
+		node.Annotations[AnnotationSuspended] = AnnotationValueTrue
+	}
 
 	// Set UID and TemplateRef
 	node.Spec.UID = row.UID
@@ -602,6 +1419,15 @@ func (r *LynqHubReconciler) shouldUpdateLynqNode(ctx context.Context, registry *
 	if storedExtraJSON != string(currentExtraJSON) {
 		return true
 	}
+	if node.Annotations[AnnotationExtraTypes] != extraTypesAnnotation(row) {
+		return true
+	}
+	if node.Labels[LabelShard] != row.Shard {
+		return true
+	}
+	if (node.Annotations[AnnotationSuspended] == AnnotationValueTrue) != rowSuspended(registry, row) {
+		return true
+	}
 
 	// Check if template has been updated
 	tmpl, err := r.getTemplateForRegistry(ctx, registry)
@@ -629,7 +1455,7 @@ func (r *LynqHubReconciler) updateLynqNode(ctx context.Context, registry *lynqv1
 		node.Annotations["lynq.sh/activate"] != row.Activate
 
 	// 1. Build template variables with new data
-	vars := template.BuildVariables(row.UID, row.HostOrURL, row.Activate, row.Extra)
+	vars := buildRowVariables(registry, row)
 
 	// 2. Render all template resources
 	renderedSpec, err := r.renderAllTemplateResources(tmpl, vars)
@@ -667,6 +1493,27 @@ func (r *LynqHubReconciler) updateLynqNode(ctx context.Context, registry *lynqv1
 		latest.Annotations["lynq.sh/activate"] = row.Activate
 		latest.Annotations["lynq.sh/extra"] = string(extraJSON)
 		latest.Annotations["lynq.sh/template-generation"] = newTemplateGeneration
+		if extraTypes := extraTypesAnnotation(row); extraTypes != "" {
+			latest.Annotations[AnnotationExtraTypes] = extraTypes
+		} else {
+			delete(latest.Annotations, AnnotationExtraTypes)
+		}
+
+		if rowSuspended(registry, row) {
+			latest.Annotations[AnnotationSuspended] = AnnotationValueTrue
+		} else {
+			delete(latest.Annotations, AnnotationSuspended)
+		}
+
+		// Track the shard the row currently comes from (federated hubs)
+		if row.Shard != "" {
+			if latest.Labels == nil {
+				latest.Labels = make(map[string]string)
+			}
+			latest.Labels[LabelShard] = row.Shard
+		} else {
+			delete(latest.Labels, LabelShard)
+		}
 
 		// Update spec with newly rendered resources
 		latest.Spec = *renderedSpec
@@ -881,13 +1728,15 @@ func (r *LynqHubReconciler) countLynqNodeStatus(ctx context.Context, registry *l
 }
 
 // updateStatus updates LynqHub status with retry on conflict
-func (r *LynqHubReconciler) updateStatus(ctx context.Context, registry *lynqv1.LynqHub, referencingTemplates, desired, ready, failed int32, synced bool) {
+// syncErr is nil when the hub synced successfully, otherwise it is reported in the Ready condition
+func (r *LynqHubReconciler) updateStatus(ctx context.Context, registry *lynqv1.LynqHub, referencingTemplates, desired, ready, failed int32, syncErr error) {
 	logger := log.FromContext(ctx)
 
 	// Record metrics first (these don't depend on the status update)
 	metrics.RegistryDesired.WithLabelValues(registry.Name, registry.Namespace).Set(float64(desired))
 	metrics.RegistryReady.WithLabelValues(registry.Name, registry.Namespace).Set(float64(ready))
 	metrics.RegistryFailed.WithLabelValues(registry.Name, registry.Namespace).Set(float64(failed))
+	metrics.RegistryRowsInvalid.WithLabelValues(registry.Name, registry.Namespace).Set(float64(registry.Status.Quarantined))
 
 	// Retry status update on conflict
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -903,6 +1752,16 @@ func (r *LynqHubReconciler) updateStatus(ctx context.Context, registry *lynqv1.L
 		latest.Status.Desired = desired
 		latest.Status.Ready = ready
 		latest.Status.Failed = failed
+		latest.Status.ChangeTracking = registry.Status.ChangeTracking
+		latest.Status.Shards = registry.Status.Shards
+		latest.Status.Quarantined = registry.Status.Quarantined
+		latest.Status.QuarantinedRows = registry.Status.QuarantinedRows
+		latest.Status.BlockedDeletions = registry.Status.BlockedDeletions
+		latest.Status.NextActivationBoundary = registry.Status.NextActivationBoundary
+		latest.Status.WriteBack = registry.Status.WriteBack
+		latest.Status.ServingEndpoint = registry.Status.ServingEndpoint
+		latest.Status.LastSyncTime = registry.Status.LastSyncTime
+		latest.Status.NextScheduledSync = registry.Status.NextScheduledSync
 		latest.Status.ObservedGeneration = latest.Generation
 
 		// Prepare condition
@@ -913,10 +1772,13 @@ func (r *LynqHubReconciler) updateStatus(ctx context.Context, registry *lynqv1.L
 			Message:            "Successfully connected to database and queried node data",
 			LastTransitionTime: metav1.Now(),
 		}
-		if !synced {
+		if syncErr != nil {
 			condition.Status = metav1.ConditionFalse
-			condition.Reason = "DatabaseConnectionFailed"
-			condition.Message = "Failed to connect to database or query node data"
+			condition.Reason, condition.Message = syncFailureReason(syncErr)
+		} else if unhealthy := unhealthyShards(registry.Status.Shards); len(unhealthy) > 0 {
+			condition.Reason = "ShardsDegraded"
+			condition.Message = fmt.Sprintf("Synced %d of %d shards; unavailable: %s",
+				len(registry.Status.Shards)-len(unhealthy), len(registry.Status.Shards), strings.Join(unhealthy, ", "))
 		}
 
 		// Update or append condition
@@ -931,6 +1793,12 @@ func (r *LynqHubReconciler) updateStatus(ctx context.Context, registry *lynqv1.L
 		if !found {
 			latest.Status.Conditions = append(latest.Status.Conditions, condition)
 		}
+		meta.SetStatusCondition(&latest.Status.Conditions, rowsInvalidCondition(latest.Status))
+		if latest.Spec.DeletionSafety != nil {
+			meta.SetStatusCondition(&latest.Status.Conditions, deletionBlockedCondition(latest.Status))
+		} else {
+			meta.RemoveStatusCondition(&latest.Status.Conditions, ConditionDeletionBlocked)
+		}
 
 		// Update status subresource
 		return r.Status().Update(ctx, latest)
@@ -941,6 +1809,25 @@ func (r *LynqHubReconciler) updateStatus(ctx context.Context, registry *lynqv1.L
 	}
 }
 
+// syncFailureReason returns the Ready condition reason and message for a failed sync
+func syncFailureReason(err error) (string, string) {
+	var tlsErr *datasource.TLSError
+	if errorsStd.As(err, &tlsErr) {
+		return "TLSHandshakeFailed", fmt.Sprintf("TLS handshake with database failed, check CA, client certificate and serverName: %v", tlsErr.Err)
+	}
+	var authErr *datasource.AuthError
+	if errorsStd.As(err, &authErr) {
+		return ReasonAuthenticationFailed, fmt.Sprintf("Data source rejected the credentials, check the password or token Secret: %v", authErr.Err)
+	}
+	if errorsStd.Is(err, errDuplicateUIDs) {
+		return "DuplicateUIDs", fmt.Sprintf("Shards returned the same uid, set source.duplicateUIDPolicy to resolve them: %v", err)
+	}
+	if errorsStd.Is(err, context.DeadlineExceeded) {
+		return "QueryTimeout", fmt.Sprintf("Database query did not finish in time, consider raising source.connection.queryTimeout: %v", err)
+	}
+	return "DatabaseConnectionFailed", fmt.Sprintf("Failed to connect to database or query node data: %v", err)
+}
+
 // cleanupRetainResources handles DeletionPolicy.Retain resources when Registry is deleted
 func (r *LynqHubReconciler) cleanupRetainResources(ctx context.Context, registry *lynqv1.LynqHub) error {
 	logger := log.FromContext(ctx)
@@ -1067,11 +1954,24 @@ func removeString(slice []string, str string) []string {
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *LynqHubReconciler) SetupWithManager(mgr ctrl.Manager, concurrency int) error {
+	// Close all cached connection pools when the manager stops
+	if r.Datasources != nil {
+		if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			return r.Datasources.Close()
+		})); err != nil {
+			return err
+		}
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&lynqv1.LynqHub{}).
 		Owns(&lynqv1.LynqNode{}).
 		// Watch LynqForms to re-sync nodes when template changes
 		Watches(&lynqv1.LynqForm{}, handler.EnqueueRequestsFromMapFunc(r.findRegistryForTemplate)).
+		// Watch ConfigMaps and Secrets holding rows, credentials or TLS material to sync on edits
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.findRegistriesForConfigMap)).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findRegistriesForSecret)).
 		Named("lynqhub").
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: concurrency,
@@ -1093,3 +1993,41 @@ func (r *LynqHubReconciler) findRegistryForTemplate(ctx context.Context, obj cli
 		},
 	}
 }
+
+// findRegistriesForConfigMap maps a ConfigMap to the kubernetes source hubs reading rows from it
+func (r *LynqHubReconciler) findRegistriesForConfigMap(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.findRegistriesReferencing(ctx, obj, func(hub *lynqv1.LynqHub) bool {
+		src := hub.Spec.Source.Kubernetes
+		return hub.Spec.Source.Type == lynqv1.SourceTypeKubernetes && src != nil &&
+			src.ConfigMapRef != nil && src.ConfigMapRef.Name == obj.GetName()
+	})
+}
+
+// findRegistriesForSecret maps a Secret to the hubs reading credentials, TLS material or rows from it,
+// so that rotated credentials are picked up (and the connection pool recycled) right away
+func (r *LynqHubReconciler) findRegistriesForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.findRegistriesReferencing(ctx, obj, func(hub *lynqv1.LynqHub) bool {
+		return referencedSecrets(hub)[obj.GetName()]
+	})
+}
+
+// findRegistriesReferencing returns reconcile requests for the hubs in the object's namespace that reference it
+func (r *LynqHubReconciler) findRegistriesReferencing(ctx context.Context, obj client.Object, references func(*lynqv1.LynqHub) bool) []reconcile.Request {
+	hubs := &lynqv1.LynqHubList{}
+	if err := r.List(ctx, hubs, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list hubs for referenced object", "object", obj.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range hubs.Items {
+		hub := &hubs.Items[i]
+		if !references(hub) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: hub.Name, Namespace: hub.Namespace},
+		})
+	}
+	return requests
+}
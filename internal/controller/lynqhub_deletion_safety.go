@@ -0,0 +1,119 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
+)
+
+const (
+	// AnnotationAllowDeletions approves the next garbage collection sweep of a hub
+	// regardless of its deletionSafety limits; the controller removes it after the sweep
+	AnnotationAllowDeletions = "lynq.sh/allow-deletions"
+
+	// ConditionDeletionBlocked is True while deletionSafety blocks garbage collection
+	ConditionDeletionBlocked = "DeletionBlocked"
+)
+
+// deletionLimitExceeded reports whether deleting deletions of existing nodes exceeds
+// the deletionSafety limits, and names the exceeded limit
+func deletionLimitExceeded(safety *lynqv1.DeletionSafety, deletions, existing int) (bool, string) {
+	if safety == nil || deletions == 0 {
+		return false, ""
+	}
+	if limit := safety.MaxDeletions; limit != nil && deletions > int(*limit) {
+		return true, fmt.Sprintf("maxDeletions=%d", *limit)
+	}
+	if percent := safety.MaxDeletionPercent; percent != nil && deletions*100 > int(*percent)*existing {
+		return true, fmt.Sprintf("maxDeletionPercent=%d", *percent)
+	}
+	return false, ""
+}
+
+// deletionsApproved reports whether the hub carries the lynq.sh/allow-deletions approval
+func deletionsApproved(registry *lynqv1.LynqHub) bool {
+	return registry.Annotations[AnnotationAllowDeletions] == AnnotationValueTrue
+}
+
+// guardDeletions applies the deletionSafety limits to the nodes a sync is about to delete
+// out of existing nodes. It returns the nodes that may be deleted, none when the limit is
+// exceeded without approval (status.blockedDeletions is set then), and whether the
+// lynq.sh/allow-deletions approval was needed for the deletion.
+func (r *LynqHubReconciler) guardDeletions(
+	ctx context.Context,
+	registry *lynqv1.LynqHub,
+	stale []*lynqv1.LynqNode,
+	existing int,
+) ([]*lynqv1.LynqNode, bool) {
+	logger := log.FromContext(ctx)
+
+	exceeded, limit := deletionLimitExceeded(registry.Spec.DeletionSafety, len(stale), existing)
+	if !exceeded {
+		return stale, false
+	}
+	if deletionsApproved(registry) {
+		logger.Info("Deleting nodes beyond the deletionSafety limit, approved by annotation",
+			"nodes", len(stale), "limit", limit)
+		r.Recorder.Eventf(registry, corev1.EventTypeNormal, "DeletionApproved",
+			"Deleting %d of %d LynqNodes beyond %s, approved by the %s annotation",
+			len(stale), existing, limit, AnnotationAllowDeletions)
+		return stale, true
+	}
+
+	logger.Info("Blocked deletions exceeding the deletionSafety limit",
+		"nodes", len(stale), "existing", existing, "limit", limit)
+	r.Recorder.Eventf(registry, corev1.EventTypeWarning, "DeletionBlocked",
+		"Sync would delete %d of %d LynqNodes, exceeding %s; annotate the hub with %s=true to approve",
+		len(stale), existing, limit, AnnotationAllowDeletions)
+	registry.Status.BlockedDeletions = int32(len(stale))
+	return nil, false
+}
+
+// clearDeletionApproval removes the lynq.sh/allow-deletions annotation.
+// A copy is patched so the status computed by the running sync is not overwritten.
+func (r *LynqHubReconciler) clearDeletionApproval(ctx context.Context, registry *lynqv1.LynqHub) error {
+	hub := registry.DeepCopy()
+	delete(hub.Annotations, AnnotationAllowDeletions)
+	return r.Patch(ctx, hub, client.MergeFrom(registry))
+}
+
+// deletionBlockedCondition returns the DeletionBlocked condition for a hub with deletionSafety
+func deletionBlockedCondition(status lynqv1.LynqHubStatus) metav1.Condition {
+	if status.BlockedDeletions == 0 {
+		return metav1.Condition{
+			Type:    ConditionDeletionBlocked,
+			Status:  metav1.ConditionFalse,
+			Reason:  "WithinLimits",
+			Message: "Garbage collection is within the deletionSafety limits",
+		}
+	}
+	return metav1.Condition{
+		Type:   ConditionDeletionBlocked,
+		Status: metav1.ConditionTrue,
+		Reason: "DeletionLimitExceeded",
+		Message: fmt.Sprintf("%d LynqNodes were not deleted because the deletionSafety limit was exceeded; "+
+			"annotate the hub with %s=true to approve the deletion", status.BlockedDeletions, AnnotationAllowDeletions),
+	}
+}
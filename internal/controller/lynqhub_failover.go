@@ -0,0 +1,52 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
+	"github.com/k8s-lynq/lynq/internal/datasource"
+)
+
+// recordServingEndpoint records the endpoint that served the last sync of registry in its status
+// and reports failing over to a replica and recovering to the primary as events
+func (r *LynqHubReconciler) recordServingEndpoint(registry *lynqv1.LynqHub, ds datasource.Datasource) {
+	reporter, ok := ds.(datasource.EndpointReporter)
+	if !ok {
+		registry.Status.ServingEndpoint = ""
+		return
+	}
+
+	endpoint, fallback := reporter.ServingEndpoint()
+	previous := registry.Status.ServingEndpoint
+	registry.Status.ServingEndpoint = endpoint
+	switch {
+	case endpoint == previous || endpoint == "":
+	case fallback:
+		r.Recorder.Eventf(registry, corev1.EventTypeWarning, "EndpointFailover",
+			"Source is served by replica %s, the primary endpoint is unavailable", endpoint)
+	case previous != "" && hasReplicas(registry):
+		r.Recorder.Eventf(registry, corev1.EventTypeNormal, "EndpointRecovered",
+			"Source is served by the primary endpoint %s again", endpoint)
+	}
+}
+
+// hasReplicas reports whether the source of registry lists replicas to fail over to
+func hasReplicas(registry *lynqv1.LynqHub) bool {
+	return registry.Spec.Source.MySQL != nil && len(registry.Spec.Source.MySQL.Replicas) > 0
+}
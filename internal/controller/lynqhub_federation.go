@@ -0,0 +1,226 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	errorsStd "errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
+	"github.com/k8s-lynq/lynq/internal/datasource"
+)
+
+// LabelShard is the label carrying the shard a node's row was read from (federated hubs only)
+const LabelShard = "lynq.sh/shard"
+
+// errDuplicateUIDs is returned when shards return the same uid and duplicateUIDPolicy is Error
+var errDuplicateUIDs = errorsStd.New("duplicate uids across shards")
+
+// queryShards reads every shard of a federated hub and passes the union of their rows to handlePage,
+// one shard page at a time, so memory stays bounded by the page size. Shards are read in the order
+// their rows take precedence (see shardReadOrder); only the uids seen so far are kept across pages.
+// A failing shard is recorded in status.shards and returned as unavailable instead of failing the
+// sync; the sync only fails when no shard could be read or duplicate uids are rejected.
+func (r *LynqHubReconciler) queryShards(
+	ctx context.Context,
+	registry *lynqv1.LynqHub,
+	since time.Time,
+	handlePage func([]datasource.NodeRow),
+) (map[string]bool, error) {
+	logger := log.FromContext(ctx)
+	shards := registry.Spec.Source.Shards
+
+	previous := make(map[string]lynqv1.ShardStatus, len(registry.Status.Shards))
+	for _, st := range registry.Status.Shards {
+		previous[st.Name] = st
+	}
+
+	now := metav1.Now()
+	merger := newShardMerger(registry.Spec.Source.DuplicateUIDPolicy)
+	unavailable := make(map[string]bool)
+	statuses := make(map[string]lynqv1.ShardStatus, len(shards))
+	keep := make(map[string]bool, len(shards))
+	var firstErr error
+	for _, shard := range shardReadOrder(shards, merger.policy) {
+		key := shardCacheKey(registry, shard.Name)
+		keep[key] = true
+
+		var rowCount int32
+		hub := shardHub(registry, shard)
+		hub.Status.ServingEndpoint = previous[shard.Name].ServingEndpoint
+		err := r.querySource(ctx, hub, key, since, func(page []datasource.NodeRow) {
+			rowCount += int32(len(page))
+			handlePage(merger.filter(shard.Name, page))
+		})
+
+		st := lynqv1.ShardStatus{
+			Name:            shard.Name,
+			Healthy:         err == nil,
+			ServingEndpoint: hub.Status.ServingEndpoint,
+			LastSyncTime:    &now,
+			Rows:            rowCount,
+		}
+		if err != nil {
+			unavailable[shard.Name] = true
+			if firstErr == nil {
+				firstErr = fmt.Errorf("shard %s: %w", shard.Name, err)
+			}
+			st.Rows = previous[shard.Name].Rows
+			st.Message = err.Error()
+			logger.Error(err, "Failed to query shard", "shard", shard.Name)
+			r.Recorder.Eventf(registry, corev1.EventTypeWarning, "ShardQueryFailed",
+				"Failed to query shard %s: %v", shard.Name, err)
+		}
+		statuses[shard.Name] = st
+	}
+	registry.Status.Shards = make([]lynqv1.ShardStatus, 0, len(shards))
+	for _, shard := range shards {
+		registry.Status.Shards = append(registry.Status.Shards, statuses[shard.Name])
+	}
+	// Shards report their endpoints themselves
+	registry.Status.ServingEndpoint = ""
+
+	if r.Datasources != nil {
+		// Close the pools of removed shards
+		_ = r.Datasources.Prune(string(registry.UID), keep)
+	}
+
+	if len(unavailable) == len(shards) {
+		return unavailable, fmt.Errorf("all %d shards failed, first error: %w", len(shards), firstErr)
+	}
+
+	// Rows passed on before the duplicates were found are applied, but a failed sync deletes nothing
+	if err := merger.err(); err != nil {
+		return unavailable, err
+	}
+	if merger.dropped > 0 {
+		logger.Info("Resolved duplicate uids across shards",
+			"policy", merger.policy, "droppedRows", merger.dropped)
+	}
+	return unavailable, nil
+}
+
+// shardHub returns a copy of registry whose source is the given shard
+func shardHub(registry *lynqv1.LynqHub, shard lynqv1.SourceShard) *lynqv1.LynqHub {
+	hub := *registry
+	hub.Spec.Source.Shards = nil
+	hub.Spec.Source.MySQL = shard.MySQL
+	hub.Spec.Source.Postgres = shard.Postgres
+	hub.Spec.Source.HTTP = shard.HTTP
+	hub.Spec.Source.Plugin = shard.Plugin
+	return &hub
+}
+
+// shardCacheKey returns the datasource cache key of a shard
+func shardCacheKey(registry *lynqv1.LynqHub, shard string) string {
+	return string(registry.UID) + "/" + shard
+}
+
+// shardUnavailable reports whether a node may belong to a shard that could not be read.
+// Nodes without a shard label are treated as unavailable while any shard is.
+func shardUnavailable(node *lynqv1.LynqNode, unavailable map[string]bool) bool {
+	if len(unavailable) == 0 {
+		return false
+	}
+	shard, ok := node.Labels[LabelShard]
+	return !ok || unavailable[shard]
+}
+
+// unhealthyShards returns the names of the shards whose last query failed
+func unhealthyShards(shards []lynqv1.ShardStatus) []string {
+	var names []string
+	for _, st := range shards {
+		if !st.Healthy {
+			names = append(names, st.Name)
+		}
+	}
+	return names
+}
+
+// shardReadOrder returns the shards in the order their rows take precedence: the list order,
+// or for ShardPriority the highest priority first and the list order on equal priority
+func shardReadOrder(shards []lynqv1.SourceShard, policy lynqv1.DuplicateUIDPolicy) []lynqv1.SourceShard {
+	ordered := append([]lynqv1.SourceShard(nil), shards...)
+	if policy == lynqv1.DuplicateUIDPolicyShardPriority {
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return ordered[i].Priority > ordered[j].Priority
+		})
+	}
+	return ordered
+}
+
+// shardMerger unions the pages of several shards, resolving duplicate uids by policy.
+// Shards must be read in shardReadOrder, so the row kept for a uid is the first one seen.
+type shardMerger struct {
+	policy lynqv1.DuplicateUIDPolicy
+	// owners maps each uid seen to the shard whose row was kept
+	owners     map[string]string
+	duplicates []string
+	dropped    int
+}
+
+func newShardMerger(policy lynqv1.DuplicateUIDPolicy) *shardMerger {
+	if policy == "" {
+		policy = lynqv1.DuplicateUIDPolicyError
+	}
+	return &shardMerger{policy: policy, owners: make(map[string]string)}
+}
+
+// filter returns the rows of a page of shard that are kept, tagged with the shard
+func (m *shardMerger) filter(shard string, page []datasource.NodeRow) []datasource.NodeRow {
+	rows := make([]datasource.NodeRow, 0, len(page))
+	for _, row := range page {
+		row.Shard = shard
+		owner, dup := m.owners[row.UID]
+		switch {
+		case !dup:
+			m.owners[row.UID] = shard
+		case owner == shard:
+			// Duplicates within a shard are passed on, so row validation quarantines them
+		case m.policy == lynqv1.DuplicateUIDPolicyError:
+			m.duplicates = append(m.duplicates, row.UID)
+			continue
+		default:
+			m.dropped++
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// err returns errDuplicateUIDs if duplicates were rejected
+func (m *shardMerger) err() error {
+	if len(m.duplicates) == 0 {
+		return nil
+	}
+	uids := m.duplicates
+	sort.Strings(uids)
+	if len(uids) > 5 {
+		uids = append(uids[:5:5], "...")
+	}
+	return fmt.Errorf("%w (duplicateUIDPolicy=Error): %d rows, uids %s",
+		errDuplicateUIDs, len(m.duplicates), strings.Join(uids, ", "))
+}
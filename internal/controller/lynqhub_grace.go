@@ -0,0 +1,166 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
+)
+
+const (
+	// AnnotationPendingDeletionSince records when the row of a LynqNode was first seen inactive or removed (RFC3339)
+	AnnotationPendingDeletionSince = "lynq.sh/pending-deletion-since"
+
+	// ConditionTypePendingDeletion is True while a LynqNode waits for the deactivation grace period of its hub
+	ConditionTypePendingDeletion = "PendingDeletion"
+)
+
+// getDeactivationGracePeriod returns the deactivation grace period of a hub (0 deletes nodes right away)
+func getDeactivationGracePeriod(registry *lynqv1.LynqHub) time.Duration {
+	if registry.Spec.DeactivationGracePeriod == "" {
+		return 0
+	}
+	grace, err := time.ParseDuration(registry.Spec.DeactivationGracePeriod)
+	if err != nil || grace < 0 {
+		return 0
+	}
+	return grace
+}
+
+// pendingDeletionDeadline returns when the grace period of a node marked PendingDeletion ends
+func pendingDeletionDeadline(node *lynqv1.LynqNode, grace time.Duration) (time.Time, bool) {
+	since, ok := node.Annotations[AnnotationPendingDeletionSince]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t.Add(grace), true
+}
+
+// pendingDeletionDue reports whether the grace period of a node marked PendingDeletion has ended
+func pendingDeletionDue(nodes []lynqv1.LynqNode, grace time.Duration, now time.Time) bool {
+	for i := range nodes {
+		if deadline, ok := pendingDeletionDeadline(&nodes[i], grace); ok && !now.Before(deadline) {
+			return true
+		}
+	}
+	return false
+}
+
+// requeueForPendingDeletion shortens the requeue interval so that the hub syncs
+// right when the next grace period ends
+func requeueForPendingDeletion(interval time.Duration, nodes []lynqv1.LynqNode, grace time.Duration, now time.Time) time.Duration {
+	for i := range nodes {
+		deadline, ok := pendingDeletionDeadline(&nodes[i], grace)
+		if !ok || !deadline.After(now) {
+			continue
+		}
+		if remaining := deadline.Sub(now); remaining < interval {
+			interval = remaining
+		}
+	}
+	return interval
+}
+
+// holdDeactivatedNode marks the node of an inactive or removed row PendingDeletion.
+// Returns true while the node must be kept, false once its grace period has ended.
+func (r *LynqHubReconciler) holdDeactivatedNode(ctx context.Context, registry *lynqv1.LynqHub, node *lynqv1.LynqNode, grace time.Duration, now time.Time) bool {
+	if deadline, ok := pendingDeletionDeadline(node, grace); ok {
+		return now.Before(deadline)
+	}
+
+	logger := log.FromContext(ctx)
+	base := node.DeepCopy()
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	node.Annotations[AnnotationPendingDeletionSince] = now.UTC().Format(time.RFC3339)
+	if err := r.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+		// The node is kept; the next sync retries the mark
+		logger.Error(err, "Failed to mark LynqNode pending deletion", "node", node.Name)
+		return true
+	}
+
+	deadline := now.Add(grace).UTC().Format(time.RFC3339)
+	if err := r.setPendingDeletionCondition(ctx, node, &metav1.Condition{
+		Type:    ConditionTypePendingDeletion,
+		Status:  metav1.ConditionTrue,
+		Reason:  "RowInactive",
+		Message: "The row is inactive or was removed; the node is deleted at " + deadline + " unless the row becomes active again",
+	}); err != nil {
+		logger.Error(err, "Failed to set PendingDeletion condition", "node", node.Name)
+	}
+
+	logger.Info("LynqNode pending deletion", "node", node.Name, "uid", node.Spec.UID, "deadline", deadline)
+	r.Recorder.Eventf(registry, corev1.EventTypeNormal, "NodePendingDeletion",
+		"LynqNode '%s' (template: %s, uid: %s) will be deleted at %s unless its row becomes active again",
+		node.Name, node.Spec.TemplateRef, node.Spec.UID, deadline)
+	return true
+}
+
+// cancelPendingDeletion removes the PendingDeletion mark of a node whose row is active again
+func (r *LynqHubReconciler) cancelPendingDeletion(ctx context.Context, registry *lynqv1.LynqHub, node *lynqv1.LynqNode) error {
+	if _, ok := node.Annotations[AnnotationPendingDeletionSince]; !ok {
+		return nil
+	}
+
+	base := node.DeepCopy()
+	delete(node.Annotations, AnnotationPendingDeletionSince)
+	if err := r.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+		return err
+	}
+	if err := r.setPendingDeletionCondition(ctx, node, nil); err != nil {
+		return err
+	}
+
+	r.Recorder.Eventf(registry, corev1.EventTypeNormal, "NodeDeletionCancelled",
+		"LynqNode '%s' (template: %s, uid: %s) is kept, its row is active again",
+		node.Name, node.Spec.TemplateRef, node.Spec.UID)
+	return nil
+}
+
+// setPendingDeletionCondition sets the PendingDeletion condition of a node, or removes it if condition is nil
+func (r *LynqHubReconciler) setPendingDeletionCondition(ctx context.Context, node *lynqv1.LynqNode, condition *metav1.Condition) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &lynqv1.LynqNode{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(node), latest); err != nil {
+			return err
+		}
+		var changed bool
+		if condition != nil {
+			changed = meta.SetStatusCondition(&latest.Status.Conditions, *condition)
+		} else {
+			changed = meta.RemoveStatusCondition(&latest.Status.Conditions, ConditionTypePendingDeletion)
+		}
+		if !changed {
+			return nil
+		}
+		return r.Status().Update(ctx, latest)
+	})
+}
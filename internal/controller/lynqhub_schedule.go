@@ -0,0 +1,108 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	errorsStd "errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
+	"github.com/k8s-lynq/lynq/internal/schedule"
+)
+
+// AnnotationSyncNow requests an immediate full sync of a hub, also outside its schedule;
+// the controller removes it after the sync completed
+const AnnotationSyncNow = "lynq.sh/sync-now"
+
+// syncSchedule returns the parsed spec.source.schedule of registry, or nil without one.
+// A schedule that does not parse or never runs (rejected by the webhook) is ignored,
+// so the hub falls back to syncInterval.
+func syncSchedule(ctx context.Context, registry *lynqv1.LynqHub) *schedule.Schedule {
+	spec := registry.Spec.Source.Schedule
+	if spec == "" {
+		return nil
+	}
+	sched, err := schedule.Parse(spec)
+	if err == nil && sched.Next(time.Now()).IsZero() {
+		err = errorsStd.New("the schedule never runs")
+	}
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Invalid schedule, using syncInterval", "schedule", spec)
+		return nil
+	}
+	return sched
+}
+
+// syncRequested reports whether the hub is annotated with lynq.sh/sync-now
+func syncRequested(registry *lynqv1.LynqHub) bool {
+	_, ok := registry.Annotations[AnnotationSyncNow]
+	return ok
+}
+
+// scheduledSyncDue reports whether a hub with a schedule syncs now: once the first activation
+// after its last successful sync has passed, when the last sync failed or the spec changed since,
+// when lynq.sh/sync-now is set, or when an activation window boundary passed
+func scheduledSyncDue(registry *lynqv1.LynqHub, sched *schedule.Schedule, now time.Time) bool {
+	last := registry.Status.LastSyncTime
+	switch {
+	case last == nil,
+		syncRequested(registry),
+		registry.Status.ObservedGeneration != registry.Generation,
+		meta.IsStatusConditionFalse(registry.Status.Conditions, "Ready"),
+		activationBoundaryDue(registry, now):
+		return true
+	}
+	next := sched.Next(last.Time)
+	return !next.IsZero() && !next.After(now)
+}
+
+// untilScheduledSync returns the time until the next activation of sched,
+// or fallback if it has none
+func untilScheduledSync(sched *schedule.Schedule, fallback time.Duration, now time.Time) time.Duration {
+	next := sched.Next(now)
+	if next.IsZero() {
+		return fallback
+	}
+	return next.Sub(now)
+}
+
+// recordSync records a successful sync of registry in its status: the sync time and,
+// for hubs with a schedule, the next scheduled sync
+func recordSync(registry *lynqv1.LynqHub, sched *schedule.Schedule, now time.Time) {
+	registry.Status.LastSyncTime = &metav1.Time{Time: now}
+	registry.Status.NextScheduledSync = nil
+	if sched == nil {
+		return
+	}
+	if next := sched.Next(now); !next.IsZero() {
+		registry.Status.NextScheduledSync = &metav1.Time{Time: next}
+	}
+}
+
+// clearSyncRequest removes the lynq.sh/sync-now annotation.
+// A copy is patched so the status computed by the running sync is not overwritten.
+func (r *LynqHubReconciler) clearSyncRequest(ctx context.Context, registry *lynqv1.LynqHub) error {
+	hub := registry.DeepCopy()
+	delete(hub.Annotations, AnnotationSyncNow)
+	return r.Patch(ctx, hub, client.MergeFrom(registry))
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
+	"github.com/k8s-lynq/lynq/internal/datasource"
+	"github.com/k8s-lynq/lynq/internal/template"
+)
+
+const (
+	// AnnotationSuspended marks a LynqNode whose row is inactive under deactivationPolicy Suspend
+	AnnotationSuspended = "lynq.sh/suspended"
+
+	// VariableSuspended is the template variable telling forms whether the node is suspended
+	VariableSuspended = "suspended"
+)
+
+// suspendsInactiveRows reports whether a hub keeps the nodes of inactive rows suspended
+func suspendsInactiveRows(registry *lynqv1.LynqHub) bool {
+	return registry.Spec.DeactivationPolicy == lynqv1.DeactivationPolicySuspend
+}
+
+// rowSuspended reports whether the node of a row is suspended instead of deleted
+func rowSuspended(registry *lynqv1.LynqHub, row datasource.NodeRow) bool {
+	return suspendsInactiveRows(registry) && !rowIsActive(registry, row, time.Now())
+}
+
+// buildRowVariables returns the template variables of a row
+func buildRowVariables(registry *lynqv1.LynqHub, row datasource.NodeRow) template.Variables {
+	vars := template.BuildVariables(row.UID, row.HostOrURL, row.Activate, row.Extra, extraTypeNames(row.ExtraTypes))
+	vars[VariableSuspended] = rowSuspended(registry, row)
+	return vars
+}
@@ -18,20 +18,30 @@ package controller
 
 import (
 	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
 	"github.com/k8s-lynq/lynq/internal/datasource"
+	"github.com/k8s-lynq/lynq/internal/schedule"
+	"github.com/k8s-lynq/lynq/internal/template"
 )
 
 // TestGetExistingNodes tests the getExistingLynqNodes function
@@ -371,7 +381,7 @@ func TestUpdateStatus(t *testing.T) {
 		desired              int32
 		ready                int32
 		failed               int32
-		synced               bool
+		syncErr              error
 		wantConditionStatus  metav1.ConditionStatus
 		wantConditionReason  string
 	}{
@@ -381,7 +391,6 @@ func TestUpdateStatus(t *testing.T) {
 			desired:              6,
 			ready:                4,
 			failed:               2,
-			synced:               true,
 			wantConditionStatus:  metav1.ConditionTrue,
 			wantConditionReason:  "DatabaseConnected",
 		},
@@ -391,17 +400,39 @@ func TestUpdateStatus(t *testing.T) {
 			desired:              0,
 			ready:                0,
 			failed:               0,
-			synced:               false,
+			syncErr:              fmt.Errorf("dial tcp: connection refused"),
 			wantConditionStatus:  metav1.ConditionFalse,
 			wantConditionReason:  "DatabaseConnectionFailed",
 		},
+		{
+			name:                 "query timeout",
+			referencingTemplates: 1,
+			syncErr:              fmt.Errorf("query timed out after 1m0s: %w", context.DeadlineExceeded),
+			wantConditionStatus:  metav1.ConditionFalse,
+			wantConditionReason:  "QueryTimeout",
+		},
+		{
+			name:                 "TLS handshake failure",
+			referencingTemplates: 1,
+			syncErr: fmt.Errorf("failed to create datasource: %w",
+				&datasource.TLSError{Err: x509.UnknownAuthorityError{}}),
+			wantConditionStatus: metav1.ConditionFalse,
+			wantConditionReason: "TLSHandshakeFailed",
+		},
+		{
+			name:                 "authentication failure",
+			referencingTemplates: 1,
+			syncErr: fmt.Errorf("failed to create datasource: %w",
+				&datasource.AuthError{Err: fmt.Errorf("Error 1045: Access denied for user 'lynq'")}),
+			wantConditionStatus: metav1.ConditionFalse,
+			wantConditionReason: ReasonAuthenticationFailed,
+		},
 		{
 			name:                 "no templates referencing registry",
 			referencingTemplates: 0,
 			desired:              0,
 			ready:                0,
 			failed:               0,
-			synced:               true,
 			wantConditionStatus:  metav1.ConditionTrue,
 			wantConditionReason:  "DatabaseConnected",
 		},
@@ -432,7 +463,7 @@ func TestUpdateStatus(t *testing.T) {
 			}
 
 			// Call updateStatus
-			r.updateStatus(ctx, registry, tt.referencingTemplates, tt.desired, tt.ready, tt.failed, tt.synced)
+			r.updateStatus(ctx, registry, tt.referencingTemplates, tt.desired, tt.ready, tt.failed, tt.syncErr)
 
 			// Verify status was updated
 			updated := &lynqv1.LynqHub{}
@@ -787,6 +818,343 @@ func TestRemoveString(t *testing.T) {
 	}
 }
 
+// TestBuildDatasourceConfig tests the buildDatasourceConfig function
+func TestBuildDatasourceConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    lynqv1.DataSource
+		password  string
+		wantCfg   datasource.Config
+		wantTable string
+		wantErr   bool
+	}{
+		{
+			name: "mysql source",
+			source: lynqv1.DataSource{
+				Type: lynqv1.SourceTypeMySQL,
+				MySQL: &lynqv1.MySQLSource{
+					Host:     "mysql.default.svc",
+					Port:     3306,
+					Username: "reader",
+					Database: "nodes",
+					Table:    "node_configs",
+					Replicas: []lynqv1.MySQLEndpoint{{Host: "mysql-replica-0"}, {Host: "mysql-replica-1", Port: 3307}},
+				},
+			},
+			password: "secret",
+			wantCfg: datasource.Config{
+				Host:     "mysql.default.svc",
+				Port:     3306,
+				Username: "reader",
+				Password: "secret",
+				Database: "nodes",
+				Replicas: []datasource.Endpoint{
+					{Host: "mysql-replica-0", Port: 3306},
+					{Host: "mysql-replica-1", Port: 3307},
+				},
+			},
+			wantTable: "node_configs",
+		},
+		{
+			name: "postgresql source with schema and sslMode",
+			source: lynqv1.DataSource{
+				Type: lynqv1.SourceTypePostgreSQL,
+				Postgres: &lynqv1.PostgreSQLSource{
+					Host:     "postgres.default.svc",
+					Port:     5432,
+					Username: "reader",
+					Database: "catalog",
+					Schema:   "tenants",
+					Table:    "node_configs",
+					SSLMode:  lynqv1.PostgreSQLSSLModeVerifyFull,
+				},
+			},
+			password: "secret",
+			wantCfg: datasource.Config{
+				Host:     "postgres.default.svc",
+				Port:     5432,
+				Username: "reader",
+				Password: "secret",
+				Database: "catalog",
+				Schema:   "tenants",
+				SSLMode:  "verify-full",
+			},
+			wantTable: "node_configs",
+		},
+		{
+			name: "http source with basic auth and cursor pagination",
+			source: lynqv1.DataSource{
+				Type: lynqv1.SourceTypeHTTP,
+				HTTP: &lynqv1.HTTPSource{
+					URL:      "https://api.example.com/v1/tenants",
+					Headers:  map[string]string{"X-Api-Version": "2"},
+					RowsPath: "$.data",
+					Auth: &lynqv1.HTTPAuth{
+						Type:      lynqv1.HTTPAuthTypeBasic,
+						Username:  "lynq",
+						SecretRef: lynqv1.SecretRef{Name: "api-credentials", Key: "password"},
+					},
+					Pagination: &lynqv1.HTTPPagination{
+						Type:        lynqv1.HTTPPaginationCursor,
+						CursorPath:  "$.next_cursor",
+						CursorParam: "cursor",
+						MaxPages:    50,
+					},
+				},
+			},
+			password: "secret",
+			wantCfg: datasource.Config{
+				Username: "lynq",
+				Password: "secret",
+				HTTP: &datasource.HTTPConfig{
+					URL:      "https://api.example.com/v1/tenants",
+					Headers:  map[string]string{"X-Api-Version": "2"},
+					AuthType: "basic",
+					RowsPath: "$.data",
+					Pagination: &datasource.HTTPPagination{
+						Type:        "cursor",
+						CursorPath:  "$.next_cursor",
+						CursorParam: "cursor",
+						MaxPages:    50,
+					},
+				},
+			},
+		},
+		{
+			name: "connection settings flow into config",
+			source: lynqv1.DataSource{
+				Type: lynqv1.SourceTypeMySQL,
+				MySQL: &lynqv1.MySQLSource{
+					Host:     "mysql.default.svc",
+					Port:     3306,
+					Username: "reader",
+					Database: "nodes",
+					Table:    "node_configs",
+				},
+				Connection: &lynqv1.ConnectionSettings{
+					MaxOpenConns:    10,
+					MaxIdleConns:    2,
+					ConnMaxLifetime: "15m",
+					ConnectTimeout:  "3s",
+					QueryTimeout:    "2m",
+				},
+			},
+			wantCfg: datasource.Config{
+				Host:            "mysql.default.svc",
+				Port:            3306,
+				Username:        "reader",
+				Database:        "nodes",
+				MaxOpenConns:    10,
+				MaxIdleConns:    2,
+				ConnMaxLifetime: "15m",
+				ConnectTimeout:  "3s",
+			},
+			wantTable: "node_configs",
+		},
+		{
+			name: "kubernetes source with configmap rows",
+			source: lynqv1.DataSource{
+				Type: lynqv1.SourceTypeKubernetes,
+				Kubernetes: &lynqv1.KubernetesSource{
+					ConfigMapRef: &lynqv1.ConfigMapRef{Name: "tenants", Key: "rows.csv"},
+					Format:       lynqv1.KubernetesRowFormatCSV,
+				},
+			},
+			wantCfg: datasource.Config{
+				Kubernetes: &datasource.KubernetesConfig{Format: "csv"},
+			},
+		},
+		{
+			name: "kubernetes source with inline rows",
+			source: lynqv1.DataSource{
+				Type: lynqv1.SourceTypeKubernetes,
+				Kubernetes: &lynqv1.KubernetesSource{
+					Format: lynqv1.KubernetesRowFormatYAML,
+					Rows: []runtime.RawExtension{
+						{Raw: []byte(`{"id":"acme","active":true}`)},
+					},
+				},
+			},
+			wantCfg: datasource.Config{
+				Kubernetes: &datasource.KubernetesConfig{
+					Format:  "json",
+					Payload: []byte(`[{"id":"acme","active":true}]`),
+				},
+			},
+		},
+		{
+			name: "plugin source with token",
+			source: lynqv1.DataSource{
+				Type: lynqv1.SourceTypePlugin,
+				Plugin: &lynqv1.PluginSource{
+					Endpoint: "dns:///lynq-plugin.tools.svc:9000",
+					Options:  map[string]string{"collection": "tenants"},
+					TokenRef: &lynqv1.SecretRef{Name: "plugin-token", Key: "token"},
+				},
+				Connection: &lynqv1.ConnectionSettings{ConnectTimeout: "2s"},
+			},
+			password: "token",
+			wantCfg: datasource.Config{
+				Password:       "token",
+				ConnectTimeout: "2s",
+				Plugin: &datasource.PluginConfig{
+					Endpoint: "dns:///lynq-plugin.tools.svc:9000",
+					Options:  map[string]string{"collection": "tenants"},
+				},
+			},
+		},
+		{
+			name:    "postgresql source without postgres block",
+			source:  lynqv1.DataSource{Type: lynqv1.SourceTypePostgreSQL},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported source type",
+			source:  lynqv1.DataSource{Type: lynqv1.SourceType("mongodb")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &LynqHubReconciler{}
+			registry := &lynqv1.LynqHub{
+				Spec: lynqv1.LynqHubSpec{Source: tt.source},
+			}
+
+			cfg, table, err := r.buildDatasourceConfig(registry, tt.password)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCfg, cfg)
+			assert.Equal(t, tt.wantTable, table)
+		})
+	}
+}
+
+// TestBuildExtraPaths tests collecting JSONPath mappings and reporting per-row extraction errors
+func TestBuildExtraPaths(t *testing.T) {
+	assert.Nil(t, buildExtraPaths(&lynqv1.LynqHub{}))
+	assert.Equal(t, map[string]string{"tier": "$.plan.tier"}, buildExtraPaths(&lynqv1.LynqHub{
+		Spec: lynqv1.LynqHubSpec{
+			ExtraValueMappings: map[string]string{"plan": "plan", "tier": "settings"},
+			ExtraValueTypes: map[string]lynqv1.ExtraValueType{
+				"plan": {Type: lynqv1.ValueTypeString},
+				"tier": {Type: lynqv1.ValueTypeAuto, Path: "$.plan.tier"},
+			},
+		},
+	}))
+
+	assert.Empty(t, extraValueErrors([]datasource.NodeRow{{UID: "acme"}}))
+	assert.Equal(t, []string{"beta/seats: not JSON", "beta/tier: not JSON"}, extraValueErrors([]datasource.NodeRow{
+		{UID: "acme"},
+		{UID: "beta", ExtraErrors: map[string]string{"tier": "not JSON", "seats": "not JSON"}},
+	}))
+}
+
+// TestBuildRelations tests converting relations and rendering them as list variables
+func TestBuildRelations(t *testing.T) {
+	assert.Nil(t, buildRelations(nil))
+	assert.Equal(t, map[string]datasource.Relation{
+		"domains": {Table: "tenant_domains", ForeignKey: "tenant_id", Columns: map[string]string{"host": "domain"}, OrderBy: "position"},
+	}, buildRelations(map[string]lynqv1.Relation{
+		"domains": {Table: "tenant_domains", ForeignKey: "tenant_id", Columns: map[string]string{"host": "domain"}, OrderBy: "position"},
+	}))
+
+	row := datasource.NodeRow{
+		UID:        "acme",
+		Extra:      map[string]string{"domains": `[{"host":"acme.example.com"},{"host":"www.acme.example.com"}]`},
+		ExtraTypes: map[string]datasource.ValueType{"domains": datasource.ValueTypeJSON},
+	}
+	vars := template.BuildVariables(row.UID, row.HostOrURL, row.Activate, row.Extra, extraTypeNames(row.ExtraTypes))
+	out, err := template.NewEngine().Render(`{{ range .domains }}{{ .host }};{{ end }}`, vars)
+	require.NoError(t, err)
+	assert.Equal(t, "acme.example.com;www.acme.example.com;", out)
+}
+
+// TestBuildFilterConditions tests the buildFilterConditions function
+func TestBuildFilterConditions(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *lynqv1.RowFilter
+		want   []datasource.FilterCondition
+	}{
+		{
+			name:   "nil filter",
+			filter: nil,
+			want:   nil,
+		},
+		{
+			name: "operators map to their operands",
+			filter: &lynqv1.RowFilter{
+				Conditions: []lynqv1.FilterCondition{
+					{Column: "region", Operator: lynqv1.FilterOperatorEquals, Value: "us-east-1"},
+					{Column: "cluster", Operator: lynqv1.FilterOperatorIn, Values: []string{"a", "b"}},
+					{Column: "deleted_at", Operator: lynqv1.FilterOperatorIsNull},
+					{Column: "note", Operator: lynqv1.FilterOperatorNotEquals},
+				},
+			},
+			want: []datasource.FilterCondition{
+				{Column: "region", Operator: datasource.FilterOperatorEquals, Values: []string{"us-east-1"}},
+				{Column: "cluster", Operator: datasource.FilterOperatorIn, Values: []string{"a", "b"}},
+				{Column: "deleted_at", Operator: datasource.FilterOperatorIsNull},
+				{Column: "note", Operator: datasource.FilterOperatorNotEquals, Values: []string{""}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildFilterConditions(tt.filter))
+		})
+	}
+}
+
+func TestBuildExtraMappings(t *testing.T) {
+	registry := &lynqv1.LynqHub{
+		Spec: lynqv1.LynqHubSpec{
+			ExtraValueMappings: map[string]string{"plan": "plan_name", "seats": "seat_count", "config": "settings", "region": "region"},
+			ExtraValueTypes: map[string]lynqv1.ExtraValueType{
+				"plan":   {Type: lynqv1.ValueTypeString},
+				"seats":  {},
+				"config": {Type: lynqv1.ValueTypeJSON},
+			},
+		},
+	}
+
+	mappings, types := buildExtraMappings(registry)
+	assert.Equal(t, map[string]string{"plan": "plan_name", "seats": "seat_count", "config": "settings", "region": "region"}, mappings)
+	assert.Equal(t, map[string]datasource.ValueType{"seats": datasource.ValueTypeAuto, "config": datasource.ValueTypeJSON}, types)
+
+	mappings, types = buildExtraMappings(&lynqv1.LynqHub{})
+	assert.Nil(t, mappings)
+	assert.Nil(t, types)
+
+	// Rows without typed values keep the annotation unset
+	assert.Empty(t, extraTypesAnnotation(datasource.NodeRow{UID: "a"}))
+	assert.JSONEq(t, `{"seats":"int"}`,
+		extraTypesAnnotation(datasource.NodeRow{UID: "a", ExtraTypes: map[string]datasource.ValueType{"seats": datasource.ValueTypeInt}}))
+}
+
+func TestBuildActivationRule(t *testing.T) {
+	assert.Nil(t, buildActivationRule(nil))
+
+	rule := buildActivationRule(&lynqv1.ActivationRule{
+		Operator:        lynqv1.ActivationOperatorNotIn,
+		Values:          []string{"suspended"},
+		CaseInsensitive: true,
+	})
+	assert.Equal(t, &datasource.ActivationRule{Values: []string{"suspended"}, Negate: true, CaseInsensitive: true}, rule)
+	assert.False(t, rule.IsActive("Suspended"))
+	assert.True(t, rule.IsActive("trial"))
+
+	rule = buildActivationRule(&lynqv1.ActivationRule{Values: []string{"active"}})
+	assert.False(t, rule.Negate, "empty operator means in")
+}
+
 // TestUpdateNodeWithConflictHandling tests that updateLynqNode handles conflicts gracefully with retry logic
 func TestUpdateNodeWithConflictHandling(t *testing.T) {
 	ctx := context.Background()
@@ -930,3 +1298,1401 @@ func TestCreateNodeIgnoresAlreadyExists(t *testing.T) {
 	// Verify that AlreadyExists error is returned (will be ignored by caller)
 	assert.Error(t, err, "createLynqNode should return error when node already exists")
 }
+
+// TestIncrementalSince tests when an incremental sync is used instead of a full sync
+func TestIncrementalSince(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	watermark := time.Date(2025, 6, 1, 11, 58, 30, 0, time.UTC)
+	templates := []*lynqv1.LynqForm{
+		{ObjectMeta: metav1.ObjectMeta{Name: "web-app", Generation: 2}},
+	}
+
+	newHub := func(tracking *lynqv1.ChangeTracking, status *lynqv1.ChangeTrackingStatus) *lynqv1.LynqHub {
+		return &lynqv1.LynqHub{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-registry", Generation: 3},
+			Spec:       lynqv1.LynqHubSpec{ChangeTracking: tracking},
+			Status:     lynqv1.LynqHubStatus{ChangeTracking: status},
+		}
+	}
+	tracking := &lynqv1.ChangeTracking{Column: "updated_at", FullResyncInterval: "10m"}
+	fingerprint := changeTrackingFingerprint(newHub(tracking, nil), templates)
+
+	tests := []struct {
+		name     string
+		registry *lynqv1.LynqHub
+		want     time.Time
+	}{
+		{
+			name:     "change tracking disabled",
+			registry: newHub(nil, &lynqv1.ChangeTrackingStatus{Watermark: watermark.Format(time.RFC3339Nano)}),
+		},
+		{
+			name:     "no watermark yet",
+			registry: newHub(tracking, nil),
+		},
+		{
+			name: "watermark within full resync interval",
+			registry: newHub(tracking, &lynqv1.ChangeTrackingStatus{
+				Watermark:        watermark.Format(time.RFC3339Nano),
+				LastFullSyncTime: &metav1.Time{Time: now.Add(-5 * time.Minute)},
+				Fingerprint:      fingerprint,
+			}),
+			want: watermark,
+		},
+		{
+			name: "full resync interval elapsed",
+			registry: newHub(tracking, &lynqv1.ChangeTrackingStatus{
+				Watermark:        watermark.Format(time.RFC3339Nano),
+				LastFullSyncTime: &metav1.Time{Time: now.Add(-10 * time.Minute)},
+				Fingerprint:      fingerprint,
+			}),
+		},
+		{
+			name: "hub or templates changed since last full sync",
+			registry: newHub(tracking, &lynqv1.ChangeTrackingStatus{
+				Watermark:        watermark.Format(time.RFC3339Nano),
+				LastFullSyncTime: &metav1.Time{Time: now.Add(-time.Minute)},
+				Fingerprint:      "stale",
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := incrementalSince(tt.registry, fingerprint, now)
+			assert.True(t, tt.want.Equal(got), "want %v, got %v", tt.want, got)
+		})
+	}
+}
+
+// TestChangeTrackingFingerprint tests that hub and template generations change the fingerprint
+func TestChangeTrackingFingerprint(t *testing.T) {
+	hub := &lynqv1.LynqHub{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+	a := &lynqv1.LynqForm{ObjectMeta: metav1.ObjectMeta{Name: "a", Generation: 1}}
+	b := &lynqv1.LynqForm{ObjectMeta: metav1.ObjectMeta{Name: "b", Generation: 1}}
+
+	base := changeTrackingFingerprint(hub, []*lynqv1.LynqForm{a, b})
+	assert.Equal(t, base, changeTrackingFingerprint(hub, []*lynqv1.LynqForm{b, a}), "template order must not matter")
+
+	bumped := &lynqv1.LynqForm{ObjectMeta: metav1.ObjectMeta{Name: "b", Generation: 2}}
+	assert.NotEqual(t, base, changeTrackingFingerprint(hub, []*lynqv1.LynqForm{a, bumped}))
+	assert.NotEqual(t, base, changeTrackingFingerprint(hub, []*lynqv1.LynqForm{a}))
+
+	hub.Generation = 2
+	assert.NotEqual(t, base, changeTrackingFingerprint(hub, []*lynqv1.LynqForm{a, b}))
+}
+
+// TestRecordFullSync tests the change tracking status recorded after a full sync
+func TestRecordFullSync(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	rows := []datasource.NodeRow{
+		{UID: "node1", ChangedAt: time.Date(2025, 6, 1, 11, 0, 0, 0, time.UTC)},
+		{UID: "node2", ChangedAt: time.Date(2025, 6, 1, 11, 30, 0, 500, time.UTC)},
+		{UID: "node3"}, // NULL change tracking column
+	}
+
+	t.Run("records latest row timestamp", func(t *testing.T) {
+		hub := &lynqv1.LynqHub{Spec: lynqv1.LynqHubSpec{ChangeTracking: &lynqv1.ChangeTracking{Column: "updated_at"}}}
+		recordFullSync(hub, latestChange(rows, time.Time{}), "fp", false, now)
+		require.NotNil(t, hub.Status.ChangeTracking)
+		assert.Equal(t, "2025-06-01T11:30:00.0000005Z", hub.Status.ChangeTracking.Watermark)
+		assert.Equal(t, "fp", hub.Status.ChangeTracking.Fingerprint)
+		assert.True(t, now.Equal(hub.Status.ChangeTracking.LastFullSyncTime.Time))
+	})
+
+	t.Run("failed sync clears status", func(t *testing.T) {
+		hub := &lynqv1.LynqHub{
+			Spec:   lynqv1.LynqHubSpec{ChangeTracking: &lynqv1.ChangeTracking{Column: "updated_at"}},
+			Status: lynqv1.LynqHubStatus{ChangeTracking: &lynqv1.ChangeTrackingStatus{Watermark: "2025-01-01T00:00:00Z"}},
+		}
+		recordFullSync(hub, latestChange(rows, time.Time{}), "fp", true, now)
+		assert.Nil(t, hub.Status.ChangeTracking)
+	})
+
+	t.Run("change tracking disabled clears status", func(t *testing.T) {
+		hub := &lynqv1.LynqHub{
+			Status: lynqv1.LynqHubStatus{ChangeTracking: &lynqv1.ChangeTrackingStatus{Watermark: "2025-01-01T00:00:00Z"}},
+		}
+		recordFullSync(hub, latestChange(rows, time.Time{}), "fp", false, now)
+		assert.Nil(t, hub.Status.ChangeTracking)
+	})
+
+	t.Run("watermark never moves backwards", func(t *testing.T) {
+		status := &lynqv1.ChangeTrackingStatus{Watermark: "2025-06-01T11:45:00Z"}
+		advanceWatermark(status, latestChange(rows, time.Time{}))
+		assert.Equal(t, "2025-06-01T11:45:00Z", status.Watermark)
+	})
+}
+
+// TestSyncChangedRows tests that an incremental sync only touches nodes of changed rows
+func TestSyncChangedRows(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, lynqv1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	registry := &lynqv1.LynqHub{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-registry",
+			Namespace: "default",
+		},
+	}
+	tmpl := &lynqv1.LynqForm{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "web-app",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: lynqv1.LynqFormSpec{
+			HubID: "test-registry",
+		},
+	}
+	newNode := func(uid string) *lynqv1.LynqNode {
+		return &lynqv1.LynqNode{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      uid + "-web-app",
+				Namespace: "default",
+				Labels: map[string]string{
+					"lynq.sh/hub": "test-registry",
+					"lynq.sh/uid": uid,
+				},
+				Annotations: map[string]string{
+					"lynq.sh/activate":            "true",
+					"lynq.sh/extra":               "{}",
+					"lynq.sh/template-generation": "1",
+				},
+			},
+			Spec: lynqv1.LynqNodeSpec{
+				UID:         uid,
+				TemplateRef: "web-app",
+			},
+		}
+	}
+	unchanged := newNode("node1")
+	deactivated := newNode("node2")
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(registry, tmpl, unchanged, deactivated).
+		Build()
+
+	r := &LynqHubReconciler{
+		Client:   fakeClient,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+
+	existing := &lynqv1.LynqNodeList{Items: []lynqv1.LynqNode{*unchanged, *deactivated}}
+	rows := []datasource.NodeRow{
+		{UID: "node2", Activate: "false", Extra: map[string]string{}},
+		{UID: "node3", Activate: "true", Extra: map[string]string{}},
+		{UID: "node3", Activate: "true", Extra: map[string]string{}},  // returned twice
+		{UID: "node4", Activate: "false", Extra: map[string]string{}}, // inactive, never created
+	}
+
+	desired, failed := r.syncChangedRows(ctx, registry, []*lynqv1.LynqForm{tmpl}, rows, existing)
+	assert.False(t, failed)
+	assert.Equal(t, int32(2), desired, "node1 kept, node2 removed, node3 added")
+
+	nodes := &lynqv1.LynqNodeList{}
+	require.NoError(t, fakeClient.List(ctx, nodes))
+	names := make([]string, 0, len(nodes.Items))
+	for _, node := range nodes.Items {
+		names = append(names, node.Name)
+	}
+	assert.ElementsMatch(t, []string{"node1-web-app", "node3-web-app"}, names)
+}
+
+// TestLoadTLSConfig tests reading MySQL TLS material from Secrets
+func TestLoadTLSConfig(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mysql-tls", Namespace: "default"},
+		Data: map[string][]byte{
+			"ca.crt":  []byte("ca-bundle"),
+			"tls.crt": []byte("client-cert"),
+			"tls.key": []byte("client-key"),
+		},
+	}
+	r := &LynqHubReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build(),
+		Scheme: scheme,
+	}
+
+	t.Run("all references resolved", func(t *testing.T) {
+		config, err := r.loadTLSConfig(ctx, "default", &lynqv1.MySQLTLS{
+			CARef:         &lynqv1.SecretRef{Name: "mysql-tls", Key: "ca.crt"},
+			ClientCertRef: &lynqv1.SecretRef{Name: "mysql-tls", Key: "tls.crt"},
+			ClientKeyRef:  &lynqv1.SecretRef{Name: "mysql-tls", Key: "tls.key"},
+			ServerName:    "mysql.internal",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []byte("ca-bundle"), config.CA)
+		assert.Equal(t, []byte("client-cert"), config.ClientCert)
+		assert.Equal(t, []byte("client-key"), config.ClientKey)
+		assert.Equal(t, "mysql.internal", config.ServerName)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := r.loadTLSConfig(ctx, "default", &lynqv1.MySQLTLS{
+			CARef: &lynqv1.SecretRef{Name: "mysql-tls", Key: "missing"},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `has no key "missing"`)
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		_, err := r.loadTLSConfig(ctx, "default", &lynqv1.MySQLTLS{
+			CARef: &lynqv1.SecretRef{Name: "absent", Key: "ca.crt"},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get TLS CA secret")
+	})
+}
+
+// TestLoadKubernetesRows tests reading row payloads from ConfigMaps and Secrets
+func TestLoadKubernetesRows(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenants", Namespace: "default"},
+		Data:       map[string]string{"rows.yaml": "- id: acme\n"},
+		BinaryData: map[string][]byte{"rows.csv": []byte("id\nacme\n")},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenants", Namespace: "default"},
+		Data:       map[string][]byte{"rows.json": []byte(`[{"id":"acme"}]`)},
+	}
+	r := &LynqHubReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm, secret).Build(),
+		Scheme: scheme,
+	}
+
+	tests := []struct {
+		name    string
+		src     *lynqv1.KubernetesSource
+		want    string
+		wantErr string
+	}{
+		{
+			name: "configmap data",
+			src:  &lynqv1.KubernetesSource{ConfigMapRef: &lynqv1.ConfigMapRef{Name: "tenants", Key: "rows.yaml"}},
+			want: "- id: acme\n",
+		},
+		{
+			name: "configmap binary data",
+			src:  &lynqv1.KubernetesSource{ConfigMapRef: &lynqv1.ConfigMapRef{Name: "tenants", Key: "rows.csv"}},
+			want: "id\nacme\n",
+		},
+		{
+			name: "secret data",
+			src:  &lynqv1.KubernetesSource{SecretRef: &lynqv1.SecretRef{Name: "tenants", Key: "rows.json"}},
+			want: `[{"id":"acme"}]`,
+		},
+		{
+			name:    "missing configmap key",
+			src:     &lynqv1.KubernetesSource{ConfigMapRef: &lynqv1.ConfigMapRef{Name: "tenants", Key: "missing"}},
+			wantErr: `has no key "missing"`,
+		},
+		{
+			name:    "missing secret",
+			src:     &lynqv1.KubernetesSource{SecretRef: &lynqv1.SecretRef{Name: "absent", Key: "rows.json"}},
+			wantErr: "failed to get rows secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := r.loadKubernetesRows(ctx, "default", tt.src)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(payload))
+		})
+	}
+}
+
+// TestFindRegistriesForRows tests mapping ConfigMaps and Secrets to the hubs reading rows from them
+func TestFindRegistriesForRows(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, lynqv1.AddToScheme(scheme))
+
+	hub := func(name, namespace string, src lynqv1.DataSource) *lynqv1.LynqHub {
+		return &lynqv1.LynqHub{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       lynqv1.LynqHubSpec{Source: src},
+		}
+	}
+	r := &LynqHubReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			hub("from-configmap", "default", lynqv1.DataSource{
+				Type:       lynqv1.SourceTypeKubernetes,
+				Kubernetes: &lynqv1.KubernetesSource{ConfigMapRef: &lynqv1.ConfigMapRef{Name: "tenants", Key: "rows"}},
+			}),
+			hub("from-secret", "default", lynqv1.DataSource{
+				Type:       lynqv1.SourceTypeKubernetes,
+				Kubernetes: &lynqv1.KubernetesSource{SecretRef: &lynqv1.SecretRef{Name: "tenants", Key: "rows"}},
+			}),
+			hub("other-namespace", "team-b", lynqv1.DataSource{
+				Type:       lynqv1.SourceTypeKubernetes,
+				Kubernetes: &lynqv1.KubernetesSource{ConfigMapRef: &lynqv1.ConfigMapRef{Name: "tenants", Key: "rows"}},
+			}),
+			hub("mysql", "default", lynqv1.DataSource{
+				Type:  lynqv1.SourceTypeMySQL,
+				MySQL: &lynqv1.MySQLSource{Host: "mysql", Table: "tenants"},
+			}),
+			hub("mysql-password", "default", lynqv1.DataSource{
+				Type: lynqv1.SourceTypeMySQL,
+				MySQL: &lynqv1.MySQLSource{
+					Host:        "mysql",
+					Table:       "nodes",
+					PasswordRef: &lynqv1.SecretRef{Name: "db-credentials", Key: "password"},
+					TLS:         &lynqv1.MySQLTLS{CARef: &lynqv1.SecretRef{Name: "db-ca", Key: "ca.crt"}},
+				},
+			}),
+			hub("sharded", "default", lynqv1.DataSource{
+				Type: lynqv1.SourceTypePostgreSQL,
+				Shards: []lynqv1.SourceShard{
+					{Name: "eu", Postgres: &lynqv1.PostgreSQLSource{Host: "pg-eu", Table: "nodes"}},
+					{Name: "us", Postgres: &lynqv1.PostgreSQLSource{
+						Host: "pg-us", Table: "nodes", PasswordRef: &lynqv1.SecretRef{Name: "db-credentials", Key: "us"},
+					}},
+				},
+			}),
+			hub("plugin", "default", lynqv1.DataSource{
+				Type:   lynqv1.SourceTypePlugin,
+				Plugin: &lynqv1.PluginSource{Endpoint: "plugin:9000", TokenRef: &lynqv1.SecretRef{Name: "plugin-token", Key: "token"}},
+			}),
+		).Build(),
+		Scheme: scheme,
+	}
+
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "tenants", Namespace: "default"}}
+	requests := r.findRegistriesForConfigMap(ctx, cm)
+	require.Len(t, requests, 1)
+	assert.Equal(t, types.NamespacedName{Name: "from-configmap", Namespace: "default"}, requests[0].NamespacedName)
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "tenants", Namespace: "default"}}
+	requests = r.findRegistriesForSecret(ctx, secret)
+	require.Len(t, requests, 1)
+	assert.Equal(t, types.NamespacedName{Name: "from-secret", Namespace: "default"}, requests[0].NamespacedName)
+
+	unrelated := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"}}
+	assert.Empty(t, r.findRegistriesForConfigMap(ctx, unrelated))
+
+	// Secrets holding passwords, tokens and TLS material, including those of shards
+	hubNames := func(requests []reconcile.Request) []string {
+		var names []string
+		for _, req := range requests {
+			names = append(names, req.Name)
+		}
+		sort.Strings(names)
+		return names
+	}
+	credentials := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "db-credentials", Namespace: "default"}}
+	assert.Equal(t, []string{"mysql-password", "sharded"}, hubNames(r.findRegistriesForSecret(ctx, credentials)))
+	ca := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "db-ca", Namespace: "default"}}
+	assert.Equal(t, []string{"mysql-password"}, hubNames(r.findRegistriesForSecret(ctx, ca)))
+	token := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "plugin-token", Namespace: "default"}}
+	assert.Equal(t, []string{"plugin"}, hubNames(r.findRegistriesForSecret(ctx, token)))
+}
+
+// TestHandleQueryFailure_Authentication tests that rejected credentials are reported without backoff retries
+func TestHandleQueryFailure_Authentication(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, lynqv1.AddToScheme(scheme))
+
+	registry := &lynqv1.LynqHub{ObjectMeta: metav1.ObjectMeta{Name: "test-registry", Namespace: "default"}}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(registry).WithStatusSubresource(registry).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &LynqHubReconciler{Client: fakeClient, Scheme: scheme, Recorder: recorder}
+
+	authErr := fmt.Errorf("failed to query nodes: %w", &datasource.AuthError{Err: fmt.Errorf("pq: password authentication failed")})
+	result, err := r.handleQueryFailure(ctx, registry, nil, time.Hour, authErr)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, result.RequeueAfter)
+	assert.Contains(t, <-recorder.Events, ReasonAuthenticationFailed)
+
+	_, err = r.handleQueryFailure(ctx, registry, nil, time.Hour, fmt.Errorf("dial tcp: connection refused"))
+	assert.Error(t, err)
+}
+
+// TestGetQueryTimeout tests the query timeout default and override
+func TestGetQueryTimeout(t *testing.T) {
+	tests := []struct {
+		name       string
+		connection *lynqv1.ConnectionSettings
+		want       time.Duration
+	}{
+		{name: "no connection settings", want: defaultQueryTimeout},
+		{name: "empty query timeout", connection: &lynqv1.ConnectionSettings{MaxOpenConns: 5}, want: defaultQueryTimeout},
+		{name: "explicit query timeout", connection: &lynqv1.ConnectionSettings{QueryTimeout: "45s"}, want: 45 * time.Second},
+		{name: "invalid query timeout", connection: &lynqv1.ConnectionSettings{QueryTimeout: "later"}, want: defaultQueryTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := &lynqv1.LynqHub{
+				Spec: lynqv1.LynqHubSpec{Source: lynqv1.DataSource{Connection: tt.connection}},
+			}
+			assert.Equal(t, tt.want, getQueryTimeout(registry))
+		})
+	}
+}
+
+// pagedDatasource serves fixed pages of rows through datasource.Pager
+type pagedDatasource struct {
+	pages  map[string][]datasource.NodeRow
+	next   map[string]string
+	afters []string
+}
+
+func (p *pagedDatasource) QueryNodes(ctx context.Context, config datasource.QueryConfig) ([]datasource.NodeRow, error) {
+	return nil, fmt.Errorf("QueryNodes must not be used when paging")
+}
+
+func (p *pagedDatasource) QueryNodePage(ctx context.Context, config datasource.QueryConfig, after string) ([]datasource.NodeRow, string, error) {
+	p.afters = append(p.afters, after)
+	if _, ok := p.pages[after]; !ok {
+		<-ctx.Done()
+		return nil, "", ctx.Err()
+	}
+	return p.pages[after], p.next[after], nil
+}
+
+func (p *pagedDatasource) Close() error {
+	return nil
+}
+
+func TestQueryPage(t *testing.T) {
+	ds := &pagedDatasource{
+		pages: map[string][]datasource.NodeRow{
+			"":  {{UID: "a"}, {UID: "b"}},
+			"b": {{UID: "c"}},
+		},
+		next: map[string]string{"": "b"},
+	}
+	config := datasource.QueryConfig{PageSize: 2}
+
+	rows, next, err := queryPage(context.Background(), ds, config, "", time.Second)
+	require.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, "b", next)
+
+	rows, next, err = queryPage(context.Background(), ds, config, next, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, []datasource.NodeRow{{UID: "c"}}, rows)
+	assert.Empty(t, next)
+	assert.Equal(t, []string{"", "b"}, ds.afters)
+
+	// Each page gets its own timeout
+	_, _, err = queryPage(context.Background(), ds, config, "stuck", 10*time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "query timed out after 10ms")
+}
+
+// TestShardMerger tests resolving duplicate uids across shards page by page
+func TestShardMerger(t *testing.T) {
+	east := lynqv1.SourceShard{Name: "east", Priority: 1}
+	west := lynqv1.SourceShard{Name: "west", Priority: 5}
+	pages := map[string][][]datasource.NodeRow{
+		"east": {{{UID: "a", HostOrURL: "a.east"}}, {{UID: "b", HostOrURL: "b.east"}}},
+		"west": {{{UID: "b", HostOrURL: "b.west"}, {UID: "c", HostOrURL: "c.west"}}},
+	}
+
+	tests := []struct {
+		name     string
+		policy   lynqv1.DuplicateUIDPolicy
+		wantErr  bool
+		wantRows []datasource.NodeRow
+	}{
+		{
+			name:    "error is the default",
+			policy:  "",
+			wantErr: true,
+		},
+		{
+			name:   "first wins keeps the earlier shard",
+			policy: lynqv1.DuplicateUIDPolicyFirstWins,
+			wantRows: []datasource.NodeRow{
+				{UID: "a", HostOrURL: "a.east", Shard: "east"},
+				{UID: "b", HostOrURL: "b.east", Shard: "east"},
+				{UID: "c", HostOrURL: "c.west", Shard: "west"},
+			},
+		},
+		{
+			name:   "shard priority reads the higher priority first",
+			policy: lynqv1.DuplicateUIDPolicyShardPriority,
+			wantRows: []datasource.NodeRow{
+				{UID: "b", HostOrURL: "b.west", Shard: "west"},
+				{UID: "c", HostOrURL: "c.west", Shard: "west"},
+				{UID: "a", HostOrURL: "a.east", Shard: "east"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merger := newShardMerger(tt.policy)
+			var rows []datasource.NodeRow
+			for _, shard := range shardReadOrder([]lynqv1.SourceShard{east, west}, merger.policy) {
+				for _, page := range pages[shard.Name] {
+					rows = append(rows, merger.filter(shard.Name, page)...)
+				}
+			}
+
+			err := merger.err()
+			if tt.wantErr {
+				require.ErrorIs(t, err, errDuplicateUIDs)
+				assert.Contains(t, err.Error(), "uids b")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRows, rows)
+			assert.Equal(t, 1, merger.dropped)
+		})
+	}
+
+	// Equal priorities keep the list order
+	assert.Equal(t, []lynqv1.SourceShard{east, {Name: "north", Priority: 1}},
+		shardReadOrder([]lynqv1.SourceShard{east, {Name: "north", Priority: 1}}, lynqv1.DuplicateUIDPolicyShardPriority))
+
+	// Duplicates within one shard are not conflicts, also across its pages; they are passed on to row validation
+	merger := newShardMerger(lynqv1.DuplicateUIDPolicyError)
+	rows := merger.filter("east", []datasource.NodeRow{{UID: "a", HostOrURL: "old"}})
+	rows = append(rows, merger.filter("east", []datasource.NodeRow{{UID: "a", HostOrURL: "new"}})...)
+	require.NoError(t, merger.err())
+	assert.Equal(t, []datasource.NodeRow{
+		{UID: "a", HostOrURL: "old", Shard: "east"},
+		{UID: "a", HostOrURL: "new", Shard: "east"},
+	}, rows)
+}
+
+// TestShardUnavailable tests which nodes garbage collection keeps while shards are unavailable
+func TestShardUnavailable(t *testing.T) {
+	node := func(shard string) *lynqv1.LynqNode {
+		n := &lynqv1.LynqNode{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"lynq.sh/hub": "tenants"}}}
+		if shard != "" {
+			n.Labels[LabelShard] = shard
+		}
+		return n
+	}
+
+	assert.False(t, shardUnavailable(node("east"), nil))
+	assert.False(t, shardUnavailable(node(""), nil))
+
+	unavailable := map[string]bool{"west": true}
+	assert.False(t, shardUnavailable(node("east"), unavailable))
+	assert.True(t, shardUnavailable(node("west"), unavailable))
+	assert.True(t, shardUnavailable(node(""), unavailable))
+}
+
+// TestQueryShards tests that shard pages are passed on one by one and that a failing shard
+// is reported without failing the sync
+func TestQueryShards(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":"acme","active":true},{"id":"beta","active":true}]`))
+	}))
+	defer healthy.Close()
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "shard down", http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":"gamma","active":true}]`))
+	}))
+	defer other.Close()
+
+	registry := &lynqv1.LynqHub{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenants", Namespace: "default", UID: types.UID("hub-uid")},
+		Spec: lynqv1.LynqHubSpec{
+			Source: lynqv1.DataSource{
+				Type: lynqv1.SourceTypeHTTP,
+				Shards: []lynqv1.SourceShard{
+					{Name: "east", HTTP: &lynqv1.HTTPSource{URL: healthy.URL}},
+					{Name: "west", HTTP: &lynqv1.HTTPSource{URL: broken.URL}},
+					{Name: "north", HTTP: &lynqv1.HTTPSource{URL: other.URL}},
+				},
+				DuplicateUIDPolicy: lynqv1.DuplicateUIDPolicyError,
+			},
+			ValueMappings: lynqv1.ValueMappings{UID: "id", Activate: "active"},
+		},
+		Status: lynqv1.LynqHubStatus{Shards: []lynqv1.ShardStatus{{Name: "west", Healthy: true, Rows: 7}}},
+	}
+	recorder := record.NewFakeRecorder(10)
+	r := &LynqHubReconciler{Recorder: recorder}
+
+	var pages [][]datasource.NodeRow
+	unavailable, err := r.queryDatabase(context.Background(), registry, time.Time{}, func(page []datasource.NodeRow) {
+		pages = append(pages, page)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"west": true}, unavailable)
+	require.Len(t, pages, 2, "each shard page is passed on by itself")
+	require.Len(t, pages[0], 2)
+	assert.Equal(t, "east", pages[0][0].Shard)
+	require.Len(t, pages[1], 1)
+	assert.Equal(t, "north", pages[1][0].Shard)
+
+	require.Len(t, registry.Status.Shards, 3)
+	assert.True(t, registry.Status.Shards[0].Healthy)
+	assert.Equal(t, int32(2), registry.Status.Shards[0].Rows)
+	assert.False(t, registry.Status.Shards[1].Healthy)
+	assert.Equal(t, int32(7), registry.Status.Shards[1].Rows, "rows of a failed shard are kept from the last sync")
+	assert.Contains(t, registry.Status.Shards[1].Message, "503")
+	assert.Contains(t, <-recorder.Events, "ShardQueryFailed")
+
+	assert.Equal(t, int32(1), registry.Status.Shards[2].Rows)
+
+	// All shards failing fails the sync
+	registry.Spec.Source.Shards[0].HTTP.URL = broken.URL
+	registry.Spec.Source.Shards[2].HTTP.URL = broken.URL
+	_, err = r.queryDatabase(context.Background(), registry, time.Time{}, func([]datasource.NodeRow) {})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "all 3 shards failed")
+}
+
+// TestRowValidator tests quarantining rows with empty, invalid or duplicate uids
+func TestRowValidator(t *testing.T) {
+	v := newRowValidator()
+
+	valid := v.filter([]datasource.NodeRow{
+		{UID: "acme"},
+		{UID: ""},
+		{UID: "Acme_Corp"},
+		{UID: "dup"},
+		{UID: "dup"},
+		{UID: "beta"},
+	})
+	assert.Equal(t, []datasource.NodeRow{{UID: "acme"}, {UID: "beta"}}, valid)
+
+	// A uid already synced from an earlier page is a duplicate too
+	valid = v.filter([]datasource.NodeRow{{UID: "beta"}, {UID: "gamma"}})
+	assert.Equal(t, []datasource.NodeRow{{UID: "gamma"}}, valid)
+
+	// So is a uid quarantined on an earlier page, even if it appears once on this page
+	valid = v.filter([]datasource.NodeRow{{UID: "dup"}, {UID: "delta"}})
+	assert.Equal(t, []datasource.NodeRow{{UID: "delta"}}, valid)
+
+	rows := v.rows()
+	require.Len(t, rows, 4)
+	assert.Equal(t, lynqv1.QuarantinedRow{UID: "", Reason: lynqv1.QuarantineReasonEmptyUID, Message: "uid column is empty or NULL"}, rows[0])
+	assert.Equal(t, "Acme_Corp", rows[1].UID)
+	assert.Equal(t, lynqv1.QuarantineReasonInvalidUID, rows[1].Reason)
+	assert.Contains(t, rows[1].Message, "not a valid DNS label")
+	assert.Equal(t, "beta", rows[2].UID)
+	assert.Equal(t, lynqv1.QuarantineReasonDuplicateUID, rows[2].Reason)
+	assert.Equal(t, "dup", rows[3].UID)
+
+	assert.True(t, v.isQuarantined("dup"))
+	assert.False(t, v.isQuarantined("acme"))
+}
+
+// TestRecordQuarantine tests how full and incremental syncs update the quarantine status
+func TestRecordQuarantine(t *testing.T) {
+	registry := &lynqv1.LynqHub{}
+	dup := lynqv1.QuarantinedRow{UID: "dup", Reason: lynqv1.QuarantineReasonDuplicateUID}
+	bad := lynqv1.QuarantinedRow{UID: "Bad", Reason: lynqv1.QuarantineReasonInvalidUID}
+
+	recordQuarantine(registry, []lynqv1.QuarantinedRow{dup}, true)
+	assert.Equal(t, int32(1), registry.Status.Quarantined)
+	assert.Equal(t, metav1.ConditionTrue, rowsInvalidCondition(registry.Status).Status)
+
+	// Incremental syncs add to the list
+	recordQuarantine(registry, []lynqv1.QuarantinedRow{bad, dup}, false)
+	assert.Equal(t, int32(2), registry.Status.Quarantined)
+	assert.Equal(t, []lynqv1.QuarantinedRow{bad, dup}, registry.Status.QuarantinedRows)
+
+	// A full sync replaces it
+	recordQuarantine(registry, nil, true)
+	assert.Zero(t, registry.Status.Quarantined)
+	assert.Nil(t, registry.Status.QuarantinedRows)
+	assert.Equal(t, "AllRowsValid", rowsInvalidCondition(registry.Status).Reason)
+
+	// The list is capped, the count is not
+	many := make([]lynqv1.QuarantinedRow, 0, maxQuarantinedRows+5)
+	for i := 0; i < maxQuarantinedRows+5; i++ {
+		many = append(many, lynqv1.QuarantinedRow{UID: fmt.Sprintf("row-%03d", i), Reason: lynqv1.QuarantineReasonDuplicateUID})
+	}
+	recordQuarantine(registry, many, true)
+	assert.Len(t, registry.Status.QuarantinedRows, maxQuarantinedRows)
+	assert.Equal(t, int32(maxQuarantinedRows+5), registry.Status.Quarantined)
+}
+
+func TestDeletionLimitExceeded(t *testing.T) {
+	int32Ptr := func(v int32) *int32 { return &v }
+	tests := []struct {
+		name      string
+		safety    *lynqv1.DeletionSafety
+		deletions int
+		existing  int
+		want      bool
+		wantLimit string
+	}{
+		{name: "not configured", deletions: 100, existing: 100},
+		{name: "nothing to delete", safety: &lynqv1.DeletionSafety{MaxDeletions: int32Ptr(0)}, existing: 10},
+		{name: "within max", safety: &lynqv1.DeletionSafety{MaxDeletions: int32Ptr(5)}, deletions: 5, existing: 10},
+		{name: "above max", safety: &lynqv1.DeletionSafety{MaxDeletions: int32Ptr(5)}, deletions: 6, existing: 100,
+			want: true, wantLimit: "maxDeletions=5"},
+		{name: "within percent", safety: &lynqv1.DeletionSafety{MaxDeletionPercent: int32Ptr(20)}, deletions: 2, existing: 10},
+		{name: "above percent", safety: &lynqv1.DeletionSafety{MaxDeletionPercent: int32Ptr(20)}, deletions: 3, existing: 10,
+			want: true, wantLimit: "maxDeletionPercent=20"},
+		{name: "empty source", safety: &lynqv1.DeletionSafety{MaxDeletionPercent: int32Ptr(50)}, deletions: 10, existing: 10,
+			want: true, wantLimit: "maxDeletionPercent=50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, limit := deletionLimitExceeded(tt.safety, tt.deletions, tt.existing)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantLimit, limit)
+		})
+	}
+}
+
+func TestGuardDeletions(t *testing.T) {
+	ctx := context.Background()
+	maxDeletions := int32(1)
+	registry := &lynqv1.LynqHub{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-registry",
+			Namespace:   "default",
+			Annotations: map[string]string{AnnotationAllowDeletions: "true"},
+		},
+		Spec: lynqv1.LynqHubSpec{DeletionSafety: &lynqv1.DeletionSafety{MaxDeletions: &maxDeletions}},
+	}
+	r := &LynqHubReconciler{Recorder: record.NewFakeRecorder(10)}
+	node1 := &lynqv1.LynqNode{ObjectMeta: metav1.ObjectMeta{Name: "node1-web-app"}}
+	node2 := &lynqv1.LynqNode{ObjectMeta: metav1.ObjectMeta{Name: "node2-web-app"}}
+	one := []*lynqv1.LynqNode{node1}
+	two := []*lynqv1.LynqNode{node1, node2}
+
+	// Deletions within the limit do not use the approval
+	nodes, approved := r.guardDeletions(ctx, registry, one, 3)
+	assert.Equal(t, one, nodes)
+	assert.False(t, approved)
+
+	nodes, approved = r.guardDeletions(ctx, registry, two, 3)
+	assert.Equal(t, two, nodes)
+	assert.True(t, approved)
+
+	delete(registry.Annotations, AnnotationAllowDeletions)
+	nodes, approved = r.guardDeletions(ctx, registry, two, 3)
+	assert.Nil(t, nodes)
+	assert.False(t, approved)
+	assert.Equal(t, int32(2), registry.Status.BlockedDeletions)
+}
+
+func TestClearDeletionApproval(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, lynqv1.AddToScheme(scheme))
+
+	registry := &lynqv1.LynqHub{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-registry",
+			Namespace:   "default",
+			Annotations: map[string]string{AnnotationAllowDeletions: "true", "team": "platform"},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(registry).WithStatusSubresource(registry).Build()
+	r := &LynqHubReconciler{Client: fakeClient, Scheme: scheme}
+
+	require.True(t, deletionsApproved(registry))
+	registry.Status.BlockedDeletions = 7
+	require.NoError(t, r.clearDeletionApproval(ctx, registry))
+
+	// The in-memory status of the running sync is kept
+	assert.Equal(t, int32(7), registry.Status.BlockedDeletions)
+
+	updated := &lynqv1.LynqHub{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: registry.Name, Namespace: registry.Namespace}, updated))
+	assert.False(t, deletionsApproved(updated))
+	assert.Equal(t, map[string]string{"team": "platform"}, updated.Annotations)
+
+	assert.Equal(t, "WithinLimits", deletionBlockedCondition(lynqv1.LynqHubStatus{}).Reason)
+	assert.Equal(t, metav1.ConditionTrue, deletionBlockedCondition(registry.Status).Status)
+}
+
+func TestDeactivationGracePeriod(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, lynqv1.AddToScheme(scheme))
+
+	registry := &lynqv1.LynqHub{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-registry", Namespace: "default"},
+		Spec:       lynqv1.LynqHubSpec{DeactivationGracePeriod: "10m"},
+	}
+	node := &lynqv1.LynqNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "acme-web-app",
+			Namespace:   "default",
+			Annotations: map[string]string{"lynq.sh/activate": "false"},
+		},
+		Spec: lynqv1.LynqNodeSpec{UID: "acme", TemplateRef: "web-app"},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(registry, node).WithStatusSubresource(node).Build()
+	r := &LynqHubReconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+
+	grace := getDeactivationGracePeriod(registry)
+	require.Equal(t, 10*time.Minute, grace)
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	getNode := func() *lynqv1.LynqNode {
+		latest := &lynqv1.LynqNode{}
+		require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: node.Name, Namespace: node.Namespace}, latest))
+		return latest
+	}
+
+	// The first sync marks the node
+	assert.True(t, r.holdDeactivatedNode(ctx, registry, node, grace, now))
+	marked := getNode()
+	assert.Equal(t, "2025-03-01T10:00:00Z", marked.Annotations[AnnotationPendingDeletionSince])
+	assert.Equal(t, "false", marked.Annotations["lynq.sh/activate"])
+	cond := meta.FindStatusCondition(marked.Status.Conditions, ConditionTypePendingDeletion)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+
+	nodes := []lynqv1.LynqNode{*marked}
+	assert.Equal(t, 4*time.Minute, requeueForPendingDeletion(time.Hour, nodes, grace, now.Add(6*time.Minute)))
+	assert.Equal(t, time.Minute, requeueForPendingDeletion(time.Minute, nodes, grace, now))
+	assert.False(t, pendingDeletionDue(nodes, grace, now.Add(9*time.Minute)))
+	assert.True(t, pendingDeletionDue(nodes, grace, now.Add(10*time.Minute)))
+
+	// Later syncs keep the node until the grace period ends
+	assert.True(t, r.holdDeactivatedNode(ctx, registry, node, grace, now.Add(5*time.Minute)))
+	assert.False(t, r.holdDeactivatedNode(ctx, registry, node, grace, now.Add(10*time.Minute)))
+
+	// An active row removes the mark
+	require.NoError(t, r.cancelPendingDeletion(ctx, registry, node))
+	restored := getNode()
+	assert.NotContains(t, restored.Annotations, AnnotationPendingDeletionSince)
+	assert.Nil(t, meta.FindStatusCondition(restored.Status.Conditions, ConditionTypePendingDeletion))
+
+	registry.Spec.DeactivationGracePeriod = ""
+	assert.Zero(t, getDeactivationGracePeriod(registry))
+}
+
+func TestSyncChangedRows_Suspend(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, lynqv1.AddToScheme(scheme))
+
+	registry := &lynqv1.LynqHub{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-registry", Namespace: "default"},
+		Spec:       lynqv1.LynqHubSpec{DeactivationPolicy: lynqv1.DeactivationPolicySuspend},
+	}
+	tmpl := &lynqv1.LynqForm{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-app", Namespace: "default", Generation: 1},
+		Spec:       lynqv1.LynqFormSpec{HubID: "test-registry"},
+	}
+	node := &lynqv1.LynqNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "acme-web-app",
+			Namespace: "default",
+			Annotations: map[string]string{
+				"lynq.sh/activate":            "true",
+				"lynq.sh/extra":               "{}",
+				"lynq.sh/template-generation": "1",
+			},
+		},
+		Spec: lynqv1.LynqNodeSpec{UID: "acme", TemplateRef: "web-app"},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(registry, tmpl, node).Build()
+	r := &LynqHubReconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+
+	inactive := datasource.NodeRow{UID: "acme", Activate: "false", Extra: map[string]string{}}
+	assert.True(t, rowSuspended(registry, inactive))
+	assert.Equal(t, true, buildRowVariables(registry, inactive)[VariableSuspended])
+
+	existing := &lynqv1.LynqNodeList{Items: []lynqv1.LynqNode{*node}}
+	desired, failed := r.syncChangedRows(ctx, registry, []*lynqv1.LynqForm{tmpl}, []datasource.NodeRow{inactive}, existing)
+	assert.False(t, failed)
+	assert.Equal(t, int32(1), desired)
+
+	suspended := &lynqv1.LynqNode{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: node.Name, Namespace: node.Namespace}, suspended))
+	assert.Equal(t, "true", suspended.Annotations[AnnotationSuspended])
+
+	// Reactivating the row resumes the node
+	active := datasource.NodeRow{UID: "acme", Activate: "true", Extra: map[string]string{}}
+	assert.True(t, r.shouldUpdateLynqNode(ctx, registry, suspended, active))
+	require.NoError(t, r.updateLynqNode(ctx, registry, tmpl, suspended, active))
+	resumed := &lynqv1.LynqNode{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: node.Name, Namespace: node.Namespace}, resumed))
+	assert.NotContains(t, resumed.Annotations, AnnotationSuspended)
+
+	registry.Spec.DeactivationPolicy = lynqv1.DeactivationPolicyDelete
+	assert.False(t, rowSuspended(registry, inactive))
+}
+
+func TestSyncChangedRows_DeletionSafety(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, lynqv1.AddToScheme(scheme))
+
+	maxDeletions := int32(1)
+	registry := &lynqv1.LynqHub{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-registry", Namespace: "default"},
+		Spec:       lynqv1.LynqHubSpec{DeletionSafety: &lynqv1.DeletionSafety{MaxDeletions: &maxDeletions}},
+	}
+	tmpl := &lynqv1.LynqForm{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-app", Namespace: "default", Generation: 1},
+		Spec:       lynqv1.LynqFormSpec{HubID: "test-registry"},
+	}
+	existing := &lynqv1.LynqNodeList{}
+	for _, uid := range []string{"node1", "node2", "node3"} {
+		existing.Items = append(existing.Items, lynqv1.LynqNode{
+			ObjectMeta: metav1.ObjectMeta{Name: uid + "-web-app", Namespace: "default"},
+			Spec:       lynqv1.LynqNodeSpec{UID: uid, TemplateRef: "web-app"},
+		})
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(registry, tmpl, &existing.Items[0], &existing.Items[1], &existing.Items[2]).
+		Build()
+	r := &LynqHubReconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+
+	rows := []datasource.NodeRow{
+		{UID: "node1", Activate: "false", Extra: map[string]string{}},
+		{UID: "node2", Activate: "false", Extra: map[string]string{}},
+	}
+	nodeNames := func() []string {
+		nodes := &lynqv1.LynqNodeList{}
+		require.NoError(t, fakeClient.List(ctx, nodes))
+		names := make([]string, 0, len(nodes.Items))
+		for _, node := range nodes.Items {
+			names = append(names, node.Name)
+		}
+		return names
+	}
+
+	// Two deactivated rows exceed maxDeletions=1: nothing is deleted and the sync is incomplete
+	desired, incomplete := r.syncChangedRows(ctx, registry, []*lynqv1.LynqForm{tmpl}, rows, existing)
+	assert.True(t, incomplete)
+	assert.Equal(t, int32(3), desired)
+	assert.Equal(t, int32(2), registry.Status.BlockedDeletions)
+	assert.ElementsMatch(t, []string{"node1-web-app", "node2-web-app", "node3-web-app"}, nodeNames())
+
+	// The approval releases the batch and is removed afterwards
+	registry.Annotations = map[string]string{AnnotationAllowDeletions: "true"}
+	require.NoError(t, fakeClient.Update(ctx, registry))
+	desired, incomplete = r.syncChangedRows(ctx, registry, []*lynqv1.LynqForm{tmpl}, rows, existing)
+	assert.False(t, incomplete)
+	assert.Equal(t, int32(1), desired)
+	assert.Equal(t, int32(0), registry.Status.BlockedDeletions)
+	assert.ElementsMatch(t, []string{"node3-web-app"}, nodeNames())
+
+	updated := &lynqv1.LynqHub{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: registry.Name, Namespace: registry.Namespace}, updated))
+	assert.False(t, deletionsApproved(updated))
+
+	// A batch within the limit leaves a new approval in place
+	updated.Annotations = map[string]string{AnnotationAllowDeletions: "true"}
+	require.NoError(t, fakeClient.Update(ctx, updated))
+	rows = []datasource.NodeRow{{UID: "node3", Activate: "false", Extra: map[string]string{}}}
+	_, incomplete = r.syncChangedRows(ctx, updated, []*lynqv1.LynqForm{tmpl}, rows, existing)
+	assert.False(t, incomplete)
+	assert.Empty(t, nodeNames())
+
+	kept := &lynqv1.LynqHub{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: registry.Name, Namespace: registry.Namespace}, kept))
+	assert.True(t, deletionsApproved(kept))
+}
+
+func TestActivationWindow(t *testing.T) {
+	registry := &lynqv1.LynqHub{}
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	row := func(from, until string) datasource.NodeRow {
+		return datasource.NodeRow{UID: "acme", Activate: "true", ActiveFrom: from, ActiveUntil: until}
+	}
+
+	tests := []struct {
+		name         string
+		row          datasource.NodeRow
+		wantActive   bool
+		wantBoundary time.Time
+	}{
+		{name: "no window", row: row("", ""), wantActive: true},
+		{name: "not started", row: row("2025-03-01T12:00:00Z", ""), wantBoundary: now.Add(2 * time.Hour)},
+		{name: "started at now", row: row("2025-03-01 10:00:00", ""), wantActive: true},
+		{name: "expires later", row: row("2025-02-01", "2025-03-01T11:30:00+01:00"), wantActive: true,
+			wantBoundary: now.Add(30 * time.Minute)},
+		{name: "expired", row: row("", "2025-03-01T10:00:00Z")},
+		{name: "inactive row", row: datasource.NodeRow{UID: "acme", Activate: "false", ActiveFrom: "2025-03-01T12:00:00Z"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantActive, rowIsActive(registry, tt.row, now))
+			boundaries := newActivationBoundaries(registry, now)
+			boundaries.observe(tt.row)
+			assert.True(t, tt.wantBoundary.Equal(boundaries.next), "boundary %s", boundaries.next)
+		})
+	}
+
+	_, err := parseActivationWindow(row("next week", ""))
+	assert.ErrorContains(t, err, "activeFrom")
+
+	validator := newRowValidator()
+	assert.Empty(t, validator.filter([]datasource.NodeRow{row("", "tomorrow")}))
+	assert.Equal(t, lynqv1.QuarantineReasonInvalidActiveWindow, validator.rows()[0].Reason)
+}
+
+func TestActivationBoundaries(t *testing.T) {
+	registry := &lynqv1.LynqHub{}
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	// Boundaries are rounded up to whole seconds
+	full := newActivationBoundaries(registry, now)
+	full.observe(datasource.NodeRow{UID: "a", Activate: "1", ActiveUntil: "2025-03-01T10:20:00.250Z"})
+	full.observe(datasource.NodeRow{UID: "b", Activate: "1", ActiveFrom: "2025-03-01T11:00:00Z"})
+	full.record(registry, true)
+	require.NotNil(t, registry.Status.NextActivationBoundary)
+	assert.True(t, registry.Status.NextActivationBoundary.Equal(&metav1.Time{Time: now.Add(20*time.Minute + time.Second)}))
+
+	assert.Equal(t, 20*time.Minute+time.Second, requeueForActivationBoundary(time.Hour, registry, now))
+	assert.Equal(t, time.Minute, requeueForActivationBoundary(time.Minute, registry, now))
+	assert.False(t, activationBoundaryDue(registry, now))
+	assert.True(t, activationBoundaryDue(registry, now.Add(21*time.Minute)))
+
+	// Incremental syncs keep an earlier boundary
+	incremental := newActivationBoundaries(registry, now)
+	incremental.observe(datasource.NodeRow{UID: "c", Activate: "1", ActiveFrom: "2025-03-02"})
+	incremental.record(registry, false)
+	assert.True(t, registry.Status.NextActivationBoundary.Equal(&metav1.Time{Time: now.Add(20*time.Minute + time.Second)}))
+
+	// A full sync without windows clears it
+	newActivationBoundaries(registry, now).record(registry, true)
+	assert.Nil(t, registry.Status.NextActivationBoundary)
+	assert.Equal(t, time.Hour, requeueForActivationBoundary(time.Hour, registry, now))
+}
+
+func TestAggregateNodeStatus(t *testing.T) {
+	node := func(uid, form string, annotations map[string]string, conditions ...metav1.Condition) lynqv1.LynqNode {
+		return lynqv1.LynqNode{
+			ObjectMeta: metav1.ObjectMeta{Name: uid + "-" + form, Annotations: annotations},
+			Spec:       lynqv1.LynqNodeSpec{UID: uid, TemplateRef: form},
+			Status:     lynqv1.LynqNodeStatus{Conditions: conditions},
+		}
+	}
+	ready := metav1.Condition{Type: ConditionTypeReady, Status: metav1.ConditionTrue, Reason: "Reconciled"}
+	notReady := metav1.Condition{Type: ConditionTypeReady, Status: metav1.ConditionFalse,
+		Reason: ReasonNotAllResourcesReady, Message: "Not all resources are ready: 1/2 ready"}
+	failedReady := metav1.Condition{Type: ConditionTypeReady, Status: metav1.ConditionFalse,
+		Reason: ReasonResourcesFailed, Message: "1 resources failed"}
+	failed := metav1.Condition{Type: ConditionTypeDegraded, Status: metav1.ConditionTrue, Reason: ReasonResourceFailures}
+
+	statuses := aggregateNodeStatus([]lynqv1.LynqNode{
+		node("acme", "web", nil, ready),
+		node("acme", "db", nil, ready),
+		node("beta", "web", nil, notReady),
+		node("beta", "db", nil, failedReady, failed),
+		node("gamma", "web", nil),
+		node("delta", "web", map[string]string{AnnotationSuspended: AnnotationValueTrue}, ready),
+		node("omega", "web", map[string]string{AnnotationPendingDeletionSince: "2025-03-01T10:00:00Z"}, ready),
+	})
+
+	assert.Equal(t, map[string]datasource.NodeStatus{
+		"acme":  {UID: "acme", Ready: true, Phase: WriteBackPhaseReady},
+		"beta":  {UID: "beta", Phase: WriteBackPhaseFailed, Message: "db: 1 resources failed"},
+		"gamma": {UID: "gamma", Phase: WriteBackPhaseProgressing, Message: "web: not reconciled yet"},
+		"delta": {UID: "delta", Phase: WriteBackPhaseSuspended},
+		"omega": {UID: "omega", Phase: WriteBackPhasePendingDeletion},
+	}, statuses)
+}
+
+func TestWriteBackState(t *testing.T) {
+	var state writeBackState
+	hub := types.UID("hub-1")
+	acme := datasource.NodeStatus{UID: "acme", Ready: true, Phase: WriteBackPhaseReady}
+	beta := datasource.NodeStatus{UID: "beta", Phase: WriteBackPhaseProgressing}
+
+	// Everything is written the first time
+	changed := state.changed(hub, "tenants", map[string]datasource.NodeStatus{"acme": acme, "beta": beta})
+	assert.Equal(t, []datasource.NodeStatus{acme, beta}, changed)
+	changed[0].LastReconciled = time.Now()
+	state.record(hub, changed[:1])
+
+	// Only unwritten or changed statuses are written again
+	assert.Equal(t, []datasource.NodeStatus{beta},
+		state.changed(hub, "tenants", map[string]datasource.NodeStatus{"acme": acme, "beta": beta}))
+	state.record(hub, []datasource.NodeStatus{beta})
+	assert.Empty(t, state.changed(hub, "tenants", map[string]datasource.NodeStatus{"acme": acme, "beta": beta}))
+
+	// Uids without nodes become inactive once, then are forgotten
+	inactive := []datasource.NodeStatus{{UID: "beta", Phase: WriteBackPhaseInactive}}
+	assert.Equal(t, inactive, state.changed(hub, "tenants", map[string]datasource.NodeStatus{"acme": acme}))
+	state.record(hub, inactive)
+	assert.Empty(t, state.changed(hub, "tenants", map[string]datasource.NodeStatus{"acme": acme}))
+
+	// A new target writes everything again
+	assert.Equal(t, []datasource.NodeStatus{acme}, state.changed(hub, "accounts", map[string]datasource.NodeStatus{"acme": acme}))
+	state.forget(hub)
+	assert.Empty(t, state.hubs)
+}
+
+func TestWriteBackStatus(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, lynqv1.AddToScheme(scheme))
+
+	registry := &lynqv1.LynqHub{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-registry", Namespace: "default", UID: "hub-1"},
+		Spec: lynqv1.LynqHubSpec{
+			Source: lynqv1.DataSource{
+				Type: lynqv1.SourceTypeKubernetes,
+				Kubernetes: &lynqv1.KubernetesSource{
+					Rows: []runtime.RawExtension{{Raw: []byte(`{"id":"acme","active":true}`)}},
+				},
+			},
+			ValueMappings: lynqv1.ValueMappings{UID: "id", Activate: "active"},
+			WriteBack:     &lynqv1.WriteBack{Columns: lynqv1.WriteBackColumns{Ready: "ready"}, MinInterval: "1m"},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(registry).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &LynqHubReconciler{Client: fakeClient, Scheme: scheme, Recorder: recorder}
+
+	// Sources without a StatusWriter report the failure without failing the sync
+	r.writeBackStatus(ctx, registry)
+	require.NotNil(t, registry.Status.WriteBack)
+	assert.Contains(t, registry.Status.WriteBack.Error, "does not support writeBack")
+	assert.Contains(t, <-recorder.Events, "WriteBackFailed")
+
+	// The next write-back waits for minInterval
+	first := registry.Status.WriteBack.LastWriteTime
+	r.writeBackStatus(ctx, registry)
+	assert.Equal(t, first, registry.Status.WriteBack.LastWriteTime)
+	assert.Empty(t, recorder.Events)
+
+	// Disabling write-back clears its status
+	registry.Spec.WriteBack = nil
+	r.writeBackStatus(ctx, registry)
+	assert.Nil(t, registry.Status.WriteBack)
+}
+
+// endpointDatasource is a datasource reporting a fixed serving endpoint
+type endpointDatasource struct {
+	endpoint string
+	fallback bool
+}
+
+func (d *endpointDatasource) QueryNodes(context.Context, datasource.QueryConfig) ([]datasource.NodeRow, error) {
+	return nil, nil
+}
+
+func (d *endpointDatasource) Close() error { return nil }
+
+func (d *endpointDatasource) ServingEndpoint() (string, bool) { return d.endpoint, d.fallback }
+
+func TestRecordServingEndpoint(t *testing.T) {
+	registry := &lynqv1.LynqHub{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenants", Namespace: "default"},
+		Spec: lynqv1.LynqHubSpec{
+			Source: lynqv1.DataSource{
+				Type: lynqv1.SourceTypeMySQL,
+				MySQL: &lynqv1.MySQLSource{
+					Host:     "db-0",
+					Port:     3306,
+					Replicas: []lynqv1.MySQLEndpoint{{Host: "db-1", Port: 3306}},
+				},
+			},
+		},
+	}
+	recorder := record.NewFakeRecorder(10)
+	r := &LynqHubReconciler{Recorder: recorder}
+
+	r.recordServingEndpoint(registry, &endpointDatasource{endpoint: "db-0:3306"})
+	assert.Equal(t, "db-0:3306", registry.Status.ServingEndpoint)
+	assert.Empty(t, recorder.Events, "the first sync from the primary is not an event")
+
+	r.recordServingEndpoint(registry, &endpointDatasource{endpoint: "db-1:3306", fallback: true})
+	assert.Equal(t, "db-1:3306", registry.Status.ServingEndpoint)
+	assert.Contains(t, <-recorder.Events, "EndpointFailover")
+
+	r.recordServingEndpoint(registry, &endpointDatasource{endpoint: "db-1:3306", fallback: true})
+	assert.Empty(t, recorder.Events, "an unchanged endpoint is not reported again")
+
+	r.recordServingEndpoint(registry, &endpointDatasource{endpoint: "db-0:3306"})
+	assert.Equal(t, "db-0:3306", registry.Status.ServingEndpoint)
+	assert.Contains(t, <-recorder.Events, "EndpointRecovered")
+
+	// Datasources without endpoints clear the field
+	r.recordServingEndpoint(registry, &pagedDatasource{})
+	assert.Empty(t, registry.Status.ServingEndpoint)
+}
+
+func TestScheduledSyncDue(t *testing.T) {
+	sched, err := schedule.Parse("0 2 * * *")
+	require.NoError(t, err)
+	lastSync := time.Date(2025, 3, 1, 2, 0, 5, 0, time.UTC)
+	ready := metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "DatabaseConnected"}
+
+	hub := func() *lynqv1.LynqHub {
+		return &lynqv1.LynqHub{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenants", Namespace: "default", Generation: 3},
+			Status: lynqv1.LynqHubStatus{
+				ObservedGeneration: 3,
+				LastSyncTime:       &metav1.Time{Time: lastSync},
+				Conditions:         []metav1.Condition{ready},
+			},
+		}
+	}
+
+	assert.False(t, scheduledSyncDue(hub(), sched, lastSync.Add(12*time.Hour)))
+	assert.True(t, scheduledSyncDue(hub(), sched, lastSync.Add(24*time.Hour)), "the next activation passed")
+
+	neverSynced := hub()
+	neverSynced.Status.LastSyncTime = nil
+	assert.True(t, scheduledSyncDue(neverSynced, sched, lastSync))
+
+	requested := hub()
+	requested.Annotations = map[string]string{AnnotationSyncNow: ""}
+	assert.True(t, scheduledSyncDue(requested, sched, lastSync.Add(time.Hour)))
+
+	changed := hub()
+	changed.Generation = 4
+	assert.True(t, scheduledSyncDue(changed, sched, lastSync.Add(time.Hour)))
+
+	failed := hub()
+	failed.Status.Conditions[0].Status = metav1.ConditionFalse
+	assert.True(t, scheduledSyncDue(failed, sched, lastSync.Add(time.Hour)), "failed syncs are retried")
+
+	boundary := hub()
+	boundary.Status.NextActivationBoundary = &metav1.Time{Time: lastSync.Add(time.Hour)}
+	assert.True(t, scheduledSyncDue(boundary, sched, lastSync.Add(time.Hour)))
+
+	// Requeue until the next activation, or the fallback without one
+	assert.Equal(t, 23*time.Hour, untilScheduledSync(sched, time.Minute, lastSync.Add(time.Hour).Truncate(time.Minute)))
+	never, err := schedule.Parse("0 0 30 2 *")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, untilScheduledSync(never, time.Minute, lastSync))
+}
+
+func TestRecordSync(t *testing.T) {
+	sched, err := schedule.Parse("0 2 * * *")
+	require.NoError(t, err)
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	registry := &lynqv1.LynqHub{}
+	recordSync(registry, sched, now)
+	assert.Equal(t, now, registry.Status.LastSyncTime.Time)
+	assert.Equal(t, time.Date(2025, 3, 2, 2, 0, 0, 0, time.UTC), registry.Status.NextScheduledSync.Time)
+
+	// Removing the schedule clears the next scheduled sync
+	recordSync(registry, nil, now)
+	assert.Nil(t, registry.Status.NextScheduledSync)
+}
+
+func TestReconcile_ScheduleNotDue(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, lynqv1.AddToScheme(scheme))
+
+	lastSync := metav1.NewTime(time.Now().UTC().Add(-time.Minute))
+	registry := &lynqv1.LynqHub{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "tenants",
+			Namespace:  "default",
+			Finalizers: []string{FinalizerLynqHub},
+		},
+		Spec: lynqv1.LynqHubSpec{
+			Source: lynqv1.DataSource{
+				Type:         lynqv1.SourceTypeMySQL,
+				SyncInterval: "30s",
+				// Never due within the test: the next activation is about a year away
+				Schedule: fmt.Sprintf("%d %d %d %d *", lastSync.Minute(), lastSync.Hour(), lastSync.Day(), int(lastSync.Month())),
+				MySQL:    &lynqv1.MySQLSource{Host: "unreachable.invalid", Port: 3306},
+			},
+		},
+		Status: lynqv1.LynqHubStatus{
+			LastSyncTime: &lastSync,
+			Conditions:   []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, Reason: "DatabaseConnected"}},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(registry).WithStatusSubresource(registry).Build()
+	r := &LynqHubReconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+
+	// The source is not queried before the schedule is due
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: registry.Name, Namespace: registry.Namespace}}
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Greater(t, result.RequeueAfter, 300*24*time.Hour)
+
+	// A grace period ending before the next slot shortens the requeue
+	node := &lynqv1.LynqNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "acme-web-app",
+			Namespace: "default",
+			Labels:    map[string]string{"lynq.sh/hub": registry.Name},
+			Annotations: map[string]string{
+				AnnotationPendingDeletionSince: time.Now().UTC().Add(-5 * time.Minute).Format(time.RFC3339),
+			},
+		},
+		Spec: lynqv1.LynqNodeSpec{UID: "acme", TemplateRef: "web-app"},
+	}
+	require.NoError(t, fakeClient.Create(ctx, node))
+	hub := &lynqv1.LynqHub{}
+	require.NoError(t, fakeClient.Get(ctx, req.NamespacedName, hub))
+	hub.Spec.DeactivationGracePeriod = "10m"
+	require.NoError(t, fakeClient.Update(ctx, hub))
+	hub.Status.ObservedGeneration = hub.Generation
+	require.NoError(t, fakeClient.Status().Update(ctx, hub))
+
+	result, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, result.RequeueAfter, 5*time.Minute)
+	assert.Greater(t, result.RequeueAfter, 4*time.Minute)
+
+	// So does an earlier activation boundary
+	boundary := metav1.NewTime(time.Now().Add(2 * time.Minute))
+	hub.Status.NextActivationBoundary = &boundary
+	require.NoError(t, fakeClient.Status().Update(ctx, hub))
+
+	result, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, result.RequeueAfter, 2*time.Minute)
+	assert.Greater(t, result.RequeueAfter, time.Minute)
+
+	// An ended grace period syncs before the schedule is due, so the unreachable source is queried
+	node.Annotations[AnnotationPendingDeletionSince] = time.Now().UTC().Add(-11 * time.Minute).Format(time.RFC3339)
+	require.NoError(t, fakeClient.Update(ctx, node))
+	_, err = r.Reconcile(ctx, req)
+	assert.ErrorContains(t, err, "failed to create datasource")
+}
+
+func TestClearSyncRequest(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, lynqv1.AddToScheme(scheme))
+
+	registry := &lynqv1.LynqHub{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-registry",
+			Namespace:   "default",
+			Annotations: map[string]string{AnnotationSyncNow: "release-42", "team": "platform"},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(registry).WithStatusSubresource(registry).Build()
+	r := &LynqHubReconciler{Client: fakeClient, Scheme: scheme}
+
+	require.True(t, syncRequested(registry))
+	registry.Status.Desired = 5
+	require.NoError(t, r.clearSyncRequest(ctx, registry))
+	assert.Equal(t, int32(5), registry.Status.Desired)
+
+	updated := &lynqv1.LynqHub{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: registry.Name, Namespace: registry.Namespace}, updated))
+	assert.False(t, syncRequested(updated))
+	assert.Equal(t, map[string]string{"team": "platform"}, updated.Annotations)
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
+	"github.com/k8s-lynq/lynq/internal/datasource"
+)
+
+const (
+	// ConditionRowsInvalid is True while rows of the hub are quarantined
+	ConditionRowsInvalid = "RowsInvalid"
+
+	// maxQuarantinedRows limits the size of status.quarantinedRows
+	maxQuarantinedRows = 100
+)
+
+// rowValidator skips rows that cannot become LynqNodes and records why (quarantine).
+// It is used for one sync; uids seen on earlier pages count as duplicates too.
+type rowValidator struct {
+	seen        map[string]bool
+	quarantined map[string]lynqv1.QuarantinedRow
+}
+
+func newRowValidator() *rowValidator {
+	return &rowValidator{
+		seen:        make(map[string]bool),
+		quarantined: make(map[string]lynqv1.QuarantinedRow),
+	}
+}
+
+// filter returns the valid rows of a page. All rows of a duplicated uid are skipped.
+func (v *rowValidator) filter(page []datasource.NodeRow) []datasource.NodeRow {
+	counts := make(map[string]int, len(page))
+	for _, row := range page {
+		counts[row.UID]++
+	}
+
+	valid := make([]datasource.NodeRow, 0, len(page))
+	for _, row := range page {
+		if reason, message := validateRowUID(row.UID); reason != "" {
+			v.quarantine(row.UID, reason, message)
+			continue
+		}
+		if _, err := parseActivationWindow(row); err != nil {
+			v.quarantine(row.UID, lynqv1.QuarantineReasonInvalidActiveWindow, err.Error())
+			continue
+		}
+		// A uid already seen or quarantined on an earlier page of the sync is a duplicate too
+		if counts[row.UID] > 1 || v.seen[row.UID] || v.isQuarantined(row.UID) {
+			v.quarantine(row.UID, lynqv1.QuarantineReasonDuplicateUID, "uid is returned by more than one row")
+			continue
+		}
+		valid = append(valid, row)
+	}
+	for _, row := range valid {
+		v.seen[row.UID] = true
+	}
+	return valid
+}
+
+// isQuarantined reports whether rows of uid were skipped
+func (v *rowValidator) isQuarantined(uid string) bool {
+	_, ok := v.quarantined[uid]
+	return ok
+}
+
+func (v *rowValidator) quarantine(uid string, reason lynqv1.QuarantineReason, message string) {
+	if _, ok := v.quarantined[uid]; !ok {
+		v.quarantined[uid] = lynqv1.QuarantinedRow{UID: uid, Reason: reason, Message: message}
+	}
+}
+
+// rows returns the quarantined uids sorted by uid
+func (v *rowValidator) rows() []lynqv1.QuarantinedRow {
+	rows := make([]lynqv1.QuarantinedRow, 0, len(v.quarantined))
+	for _, row := range v.quarantined {
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].UID < rows[j].UID })
+	return rows
+}
+
+// validateRowUID checks that a uid can name LynqNodes ({uid}-{template}) and their resources
+func validateRowUID(uid string) (lynqv1.QuarantineReason, string) {
+	if uid == "" {
+		return lynqv1.QuarantineReasonEmptyUID, "uid column is empty or NULL"
+	}
+	if errs := validation.IsDNS1123Label(uid); len(errs) > 0 {
+		return lynqv1.QuarantineReasonInvalidUID, "uid is not a valid DNS label: " + strings.Join(errs, "; ")
+	}
+	return "", ""
+}
+
+// recordQuarantine stores the rows skipped by a sync in the hub status.
+// A full sync replaces the list; an incremental sync only reads changed rows and adds to it.
+func recordQuarantine(registry *lynqv1.LynqHub, rows []lynqv1.QuarantinedRow, fullSync bool) {
+	count := len(rows)
+	if !fullSync {
+		known := make(map[string]bool, len(registry.Status.QuarantinedRows))
+		for _, row := range registry.Status.QuarantinedRows {
+			known[row.UID] = true
+		}
+		count = int(registry.Status.Quarantined)
+		merged := append([]lynqv1.QuarantinedRow(nil), registry.Status.QuarantinedRows...)
+		for _, row := range rows {
+			if !known[row.UID] {
+				merged = append(merged, row)
+				count++
+			}
+		}
+		sort.Slice(merged, func(i, j int) bool { return merged[i].UID < merged[j].UID })
+		rows = merged
+	}
+
+	if len(rows) > maxQuarantinedRows {
+		rows = rows[:maxQuarantinedRows]
+	}
+	if len(rows) == 0 {
+		rows = nil
+	}
+	registry.Status.QuarantinedRows = rows
+	registry.Status.Quarantined = int32(count)
+}
+
+// rowsInvalidCondition returns the RowsInvalid condition for the quarantine status of a hub
+func rowsInvalidCondition(status lynqv1.LynqHubStatus) metav1.Condition {
+	if status.Quarantined == 0 {
+		return metav1.Condition{
+			Type:    ConditionRowsInvalid,
+			Status:  metav1.ConditionFalse,
+			Reason:  "AllRowsValid",
+			Message: "All rows passed validation",
+		}
+	}
+	return metav1.Condition{
+		Type:   ConditionRowsInvalid,
+		Status: metav1.ConditionTrue,
+		Reason: "RowsQuarantined",
+		Message: fmt.Sprintf("%d uids were skipped because their rows failed validation; see status.quarantinedRows",
+			status.Quarantined),
+	}
+}
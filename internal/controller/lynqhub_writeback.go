@@ -0,0 +1,295 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	lynqv1 "github.com/k8s-lynq/lynq/api/v1"
+	"github.com/k8s-lynq/lynq/internal/datasource"
+)
+
+// Phases written to the writeBack phase column
+const (
+	WriteBackPhaseReady           = "Ready"
+	WriteBackPhaseProgressing     = "Progressing"
+	WriteBackPhaseFailed          = "Failed"
+	WriteBackPhaseSuspended       = "Suspended"
+	WriteBackPhasePendingDeletion = "PendingDeletion"
+	WriteBackPhaseInactive        = "Inactive"
+)
+
+const (
+	// defaultWriteBackInterval is the minimum time between two write-backs when writeBack.minInterval is not set
+	defaultWriteBackInterval = 30 * time.Second
+
+	// maxWriteBackMessageLength bounds the messages written to the message column
+	maxWriteBackMessageLength = 1024
+)
+
+// writeBackState remembers the statuses written for each hub, so that a write-back
+// only updates the rows whose status changed. It is kept in memory; after a restart
+// the first write-back of a hub writes every uid again.
+type writeBackState struct {
+	mu   sync.Mutex
+	hubs map[types.UID]*hubWriteBack
+}
+
+// hubWriteBack holds the statuses written for one hub
+type hubWriteBack struct {
+	// target identifies the table and columns the statuses were written to
+	target string
+	// written is the last status written for each uid, without LastReconciled
+	written map[string]datasource.NodeStatus
+}
+
+// changed returns the statuses that differ from the ones last written to target, sorted by uid.
+// Uids written before that have no LynqNodes left are returned with phase Inactive.
+func (s *writeBackState) changed(hub types.UID, target string, statuses map[string]datasource.NodeStatus) []datasource.NodeStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := s.hubs[hub]
+	if state == nil || state.target != target {
+		state = &hubWriteBack{target: target, written: make(map[string]datasource.NodeStatus)}
+		if s.hubs == nil {
+			s.hubs = make(map[types.UID]*hubWriteBack)
+		}
+		s.hubs[hub] = state
+	}
+
+	var changed []datasource.NodeStatus
+	for uid, status := range statuses {
+		if previous, ok := state.written[uid]; !ok || previous != status {
+			changed = append(changed, status)
+		}
+	}
+	for uid := range state.written {
+		if _, ok := statuses[uid]; !ok {
+			changed = append(changed, datasource.NodeStatus{UID: uid, Phase: WriteBackPhaseInactive})
+		}
+	}
+	sort.Slice(changed, func(i, j int) bool { return changed[i].UID < changed[j].UID })
+	return changed
+}
+
+// record marks statuses as written. Inactive uids are forgotten once written.
+func (s *writeBackState) record(hub types.UID, statuses []datasource.NodeStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := s.hubs[hub]
+	if state == nil {
+		return
+	}
+	for _, status := range statuses {
+		if status.Phase == WriteBackPhaseInactive {
+			delete(state.written, status.UID)
+			continue
+		}
+		status.LastReconciled = time.Time{}
+		state.written[status.UID] = status
+	}
+}
+
+// forget drops the write-back state of a hub
+func (s *writeBackState) forget(hub types.UID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.hubs, hub)
+}
+
+// writeBackInterval returns the minimum time between two write-backs
+func writeBackInterval(wb *lynqv1.WriteBack) time.Duration {
+	interval, err := time.ParseDuration(wb.MinInterval)
+	if err != nil || interval < 0 {
+		return defaultWriteBackInterval
+	}
+	return interval
+}
+
+// writeBackConfig returns the datasource write configuration of a hub whose source table is table
+func writeBackConfig(registry *lynqv1.LynqHub, table string) datasource.WriteConfig {
+	wb := registry.Spec.WriteBack
+	if wb.Table != "" {
+		table = wb.Table
+	}
+	return datasource.WriteConfig{
+		Table:     table,
+		UIDColumn: registry.Spec.ValueMappings.UID,
+		Columns: datasource.StatusColumns{
+			Ready:          wb.Columns.Ready,
+			Phase:          wb.Columns.Phase,
+			Message:        wb.Columns.Message,
+			LastReconciled: wb.Columns.LastReconciled,
+		},
+		BatchSize: int(wb.BatchSize),
+	}
+}
+
+// writeBackStatus writes the aggregated status of the hub's LynqNodes back to the source table.
+// Only uids whose status changed are written, at most once per writeBack.minInterval.
+// Failures are reported in status.writeBack and an event; they never fail the sync.
+func (r *LynqHubReconciler) writeBackStatus(ctx context.Context, registry *lynqv1.LynqHub) {
+	wb := registry.Spec.WriteBack
+	if wb == nil {
+		registry.Status.WriteBack = nil
+		r.writeBacks.forget(registry.UID)
+		return
+	}
+
+	now := time.Now()
+	if st := registry.Status.WriteBack; st != nil && st.LastWriteTime != nil &&
+		now.Before(st.LastWriteTime.Add(writeBackInterval(wb))) {
+		return
+	}
+
+	nodes, err := r.getExistingLynqNodes(ctx, registry)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list nodes for status write-back")
+		return
+	}
+
+	ds, table, release, err := r.openSource(ctx, registry, string(registry.UID))
+	if err != nil {
+		r.recordWriteBackFailure(ctx, registry, now, 0, err)
+		return
+	}
+	defer release()
+
+	writer, ok := ds.(datasource.StatusWriter)
+	if !ok {
+		r.recordWriteBackFailure(ctx, registry, now, 0,
+			fmt.Errorf("source type %s does not support writeBack", registry.Spec.Source.Type))
+		return
+	}
+
+	config := writeBackConfig(registry, table)
+	target := fmt.Sprintf("%s/%s/%+v", config.Table, config.UIDColumn, config.Columns)
+	changed := r.writeBacks.changed(registry.UID, target, aggregateNodeStatus(nodes.Items))
+	if len(changed) == 0 {
+		return
+	}
+	for i := range changed {
+		changed[i].LastReconciled = now
+	}
+
+	writeCtx, cancel := context.WithTimeout(ctx, getQueryTimeout(registry))
+	defer cancel()
+	written, err := writer.WriteStatus(writeCtx, config, changed)
+	r.writeBacks.record(registry.UID, changed[:written])
+	if err != nil {
+		r.recordWriteBackFailure(ctx, registry, now, written, err)
+		return
+	}
+
+	log.FromContext(ctx).V(1).Info("Wrote node status back to the source", "rows", written)
+	registry.Status.WriteBack = &lynqv1.WriteBackStatus{
+		LastWriteTime: &metav1.Time{Time: now},
+		WrittenRows:   int32(written),
+	}
+}
+
+// recordWriteBackFailure reports a failed write-back. The failure counts as a write-back,
+// so it is retried after writeBack.minInterval.
+func (r *LynqHubReconciler) recordWriteBackFailure(ctx context.Context, registry *lynqv1.LynqHub, now time.Time, written int, err error) {
+	log.FromContext(ctx).Error(err, "Failed to write node status back to the source", "writtenRows", written)
+	r.Recorder.Eventf(registry, corev1.EventTypeWarning, "WriteBackFailed",
+		"Failed to write node status back to the source: %v", err)
+	registry.Status.WriteBack = &lynqv1.WriteBackStatus{
+		LastWriteTime: &metav1.Time{Time: now},
+		WrittenRows:   int32(written),
+		Error:         err.Error(),
+	}
+}
+
+// aggregateNodeStatus returns the status of every uid, aggregated over its LynqNodes (one per form):
+// PendingDeletion and Suspended reflect the row, otherwise the uid is Failed if a node is degraded
+// by failures or conflicts, Progressing if a node is not ready yet, and Ready if every node is.
+// The message is the Ready condition message of the first node (by form) that is not ready.
+func aggregateNodeStatus(nodes []lynqv1.LynqNode) map[string]datasource.NodeStatus {
+	byUID := make(map[string][]*lynqv1.LynqNode)
+	for i := range nodes {
+		node := &nodes[i]
+		byUID[node.Spec.UID] = append(byUID[node.Spec.UID], node)
+	}
+
+	statuses := make(map[string]datasource.NodeStatus, len(byUID))
+	for uid, uidNodes := range byUID {
+		sort.Slice(uidNodes, func(i, j int) bool { return uidNodes[i].Spec.TemplateRef < uidNodes[j].Spec.TemplateRef })
+
+		status := datasource.NodeStatus{UID: uid, Phase: WriteBackPhaseReady}
+		var pending, suspended, failed, progressing bool
+		for _, node := range uidNodes {
+			if _, ok := node.Annotations[AnnotationPendingDeletionSince]; ok {
+				pending = true
+			}
+			if node.Annotations[AnnotationSuspended] == AnnotationValueTrue {
+				suspended = true
+			}
+			if meta.IsStatusConditionTrue(node.Status.Conditions, ConditionTypeReady) {
+				continue
+			}
+			if degraded := meta.FindStatusCondition(node.Status.Conditions, ConditionTypeDegraded); degraded != nil &&
+				degraded.Status == metav1.ConditionTrue && degraded.Reason != ReasonResourcesNotReady {
+				failed = true
+			} else {
+				progressing = true
+			}
+			if status.Message == "" {
+				status.Message = nodeReadyMessage(node)
+			}
+		}
+
+		switch {
+		case pending:
+			status.Phase = WriteBackPhasePendingDeletion
+		case suspended:
+			status.Phase = WriteBackPhaseSuspended
+		case failed:
+			status.Phase = WriteBackPhaseFailed
+		case progressing:
+			status.Phase = WriteBackPhaseProgressing
+		}
+		status.Ready = status.Phase == WriteBackPhaseReady
+		statuses[uid] = status
+	}
+	return statuses
+}
+
+// nodeReadyMessage returns "<form>: <Ready condition message>" for a node that is not ready
+func nodeReadyMessage(node *lynqv1.LynqNode) string {
+	message := "not reconciled yet"
+	if cond := meta.FindStatusCondition(node.Status.Conditions, ConditionTypeReady); cond != nil && cond.Message != "" {
+		message = cond.Message
+	}
+	message = node.Spec.TemplateRef + ": " + message
+	if runes := []rune(message); len(runes) > maxWriteBackMessageLength {
+		message = string(runes[:maxWriteBackMessageLength])
+	}
+	return message
+}
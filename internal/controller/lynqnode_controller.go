@@ -69,6 +69,8 @@ const (
 	AnnotationCreatedOnce = "lynq.sh/created-once"
 	// Annotation value for created resources
 	AnnotationValueTrue = "true"
+	// Annotation key for the JSON map of typed extra values (key -> int, float, bool or json)
+	AnnotationExtraTypes = "lynq.sh/extra-types"
 
 	// Finalizer for node cleanup
 	LynqNodeFinalizer = "lynqnode.operator.lynq.sh/finalizer"
@@ -213,6 +215,17 @@ func (r *LynqNodeReconciler) applyResources(ctx context.Context, node *lynqv1.Ly
 			continue
 		}
 
+		// A suspended node runs without its HorizontalPodAutoscalers, they are recreated on resume
+		if suspendedAutoscaler(obj, node) {
+			if err := r.removeSuspendedAutoscaler(ctx, obj, node); err != nil {
+				logger.Error(err, "Failed to remove autoscaler of suspended node", "id", resource.ID)
+				failedCount++
+				continue
+			}
+			readyCount++
+			continue
+		}
+
 		// Handle CreationPolicy.Once
 		if resource.CreationPolicy == lynqv1.CreationPolicyOnce {
 			// Check if resource already exists and has the "created-once" annotation
@@ -579,7 +592,17 @@ func (r *LynqNodeReconciler) buildTemplateVariablesFromAnnotations(node *lynqv1.
 		}
 	}
 
-	return template.BuildVariables(node.Spec.UID, hostOrURL, activate, extraValues), nil
+	// Parse extra value types (absent for plain string values)
+	var extraTypes map[string]string
+	if typesJSON := node.Annotations[AnnotationExtraTypes]; typesJSON != "" {
+		if err := json.Unmarshal([]byte(typesJSON), &extraTypes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal extra value types: %w", err)
+		}
+	}
+
+	vars := template.BuildVariables(node.Spec.UID, hostOrURL, activate, extraValues, extraTypes)
+	vars[VariableSuspended] = node.Annotations[AnnotationSuspended] == AnnotationValueTrue
+	return vars, nil
 }
 
 // collectResourcesFromLynqNode collects all resources from LynqNode.Spec
@@ -669,9 +692,49 @@ func (r *LynqNodeReconciler) renderResource(ctx context.Context, engine *templat
 	}
 	obj.Object = renderedSpec
 
+	// Stop the workloads of a suspended node
+	if node.Annotations[AnnotationSuspended] == AnnotationValueTrue {
+		if err := suspendWorkload(obj); err != nil {
+			return nil, fmt.Errorf("failed to suspend %s: %w", obj.GetKind(), err)
+		}
+	}
+
 	return obj, nil
 }
 
+// suspendWorkload scales Deployments and StatefulSets to zero and suspends CronJobs.
+// HorizontalPodAutoscalers are removed by applyResources instead (see suspendedAutoscaler).
+func suspendWorkload(obj *unstructured.Unstructured) error {
+	gvk := obj.GroupVersionKind()
+	switch {
+	case gvk.Group == "apps" && (gvk.Kind == "Deployment" || gvk.Kind == "StatefulSet"):
+		return unstructured.SetNestedField(obj.Object, int64(0), "spec", "replicas")
+	case gvk.Group == "batch" && gvk.Kind == "CronJob":
+		return unstructured.SetNestedField(obj.Object, true, "spec", "suspend")
+	}
+	return nil
+}
+
+// suspendedAutoscaler reports whether obj is a HorizontalPodAutoscaler of a suspended node
+func suspendedAutoscaler(obj *unstructured.Unstructured, node *lynqv1.LynqNode) bool {
+	gvk := obj.GroupVersionKind()
+	return node.Annotations[AnnotationSuspended] == AnnotationValueTrue &&
+		gvk.Group == "autoscaling" && gvk.Kind == "HorizontalPodAutoscaler"
+}
+
+// removeSuspendedAutoscaler deletes the HorizontalPodAutoscaler of a suspended node, which
+// would otherwise scale the suspended workload back up. An autoscaler owned by someone else is left alone.
+func (r *LynqNodeReconciler) removeSuspendedAutoscaler(ctx context.Context, obj *unstructured.Unstructured, node *lynqv1.LynqNode) error {
+	current := obj.DeepCopy()
+	if err := r.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if r.hasOwnershipConflict(current, node) {
+		return nil
+	}
+	return client.IgnoreNotFound(r.Delete(ctx, current))
+}
+
 // renderUnstructured recursively renders template variables in unstructured data
 //
 //nolint:unparam // error return kept for future template rendering errors
@@ -1722,6 +1785,12 @@ func (r *LynqNodeReconciler) checkResourcesReadiness(
 			continue
 		}
 
+		// The autoscalers of a suspended node are removed on purpose
+		if suspendedAutoscaler(obj, node) {
+			readyCount++
+			continue
+		}
+
 		// Get current resource from cluster
 		current := obj.DeepCopy()
 		err = r.Get(ctx, client.ObjectKey{
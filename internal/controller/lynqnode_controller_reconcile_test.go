@@ -22,7 +22,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -271,6 +273,140 @@ func TestRenderResource(t *testing.T) {
 	}
 }
 
+// TestRenderResource_Suspended tests that suspended nodes stop their workloads
+func TestRenderResource_Suspended(t *testing.T) {
+	scheme := runtime.NewScheme()
+	r := &LynqNodeReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Scheme: scheme,
+	}
+	node := &lynqv1.LynqNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-node",
+			Namespace:   "default",
+			Annotations: map[string]string{AnnotationSuspended: "true"},
+		},
+		Spec: lynqv1.LynqNodeSpec{UID: "test-uid"},
+	}
+	resource := func(apiVersion, kind string, spec map[string]interface{}) lynqv1.TResource {
+		return lynqv1.TResource{
+			ID:           kind,
+			NameTemplate: "test",
+			Spec: unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": apiVersion,
+				"kind":       kind,
+				"spec":       spec,
+			}},
+		}
+	}
+
+	ctx := context.Background()
+	vars, err := r.buildTemplateVariablesFromAnnotations(node)
+	require.NoError(t, err)
+	assert.Equal(t, true, vars[VariableSuspended])
+	engine := template.NewEngine()
+
+	for _, kind := range []string{"Deployment", "StatefulSet"} {
+		obj, err := r.renderResource(ctx, engine, resource("apps/v1", kind, map[string]interface{}{"replicas": int64(3)}), vars, node)
+		require.NoError(t, err)
+		replicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		assert.Zero(t, replicas, kind)
+	}
+
+	obj, err := r.renderResource(ctx, engine, resource("batch/v1", "CronJob", map[string]interface{}{"schedule": "0 * * * *"}), vars, node)
+	require.NoError(t, err)
+	suspend, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend")
+	assert.True(t, suspend)
+
+	obj, err = r.renderResource(ctx, engine, resource("v1", "ConfigMap", map[string]interface{}{"mode": "{{ if .suspended }}off{{ else }}on{{ end }}"}), vars, node)
+	require.NoError(t, err)
+	mode, _, _ := unstructured.NestedString(obj.Object, "spec", "mode")
+	assert.Equal(t, "off", mode)
+
+	// Active nodes are rendered unchanged
+	node.Annotations = nil
+	vars, err = r.buildTemplateVariablesFromAnnotations(node)
+	require.NoError(t, err)
+	obj, err = r.renderResource(ctx, engine, resource("apps/v1", "Deployment", map[string]interface{}{"replicas": int64(3)}), vars, node)
+	require.NoError(t, err)
+	replicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	assert.Equal(t, int64(3), replicas)
+}
+
+func TestSuspendedAutoscaler(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, lynqv1.AddToScheme(scheme))
+	require.NoError(t, autoscalingv2.AddToScheme(scheme))
+
+	node := &lynqv1.LynqNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-node",
+			Namespace:   "default",
+			UID:         "node-uid",
+			Annotations: map[string]string{AnnotationSuspended: "true"},
+		},
+		Spec: lynqv1.LynqNodeSpec{UID: "test-uid"},
+	}
+	hpa := func(name string, owner types.UID) *autoscalingv2.HorizontalPodAutoscaler {
+		return &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "operator.lynq.sh/v1", Kind: "LynqNode", Name: "owner", UID: owner},
+				},
+			},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{MaxReplicas: 5},
+		}
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(node, hpa("web", node.UID), hpa("shared", "other-uid")).
+		Build()
+	r := &LynqNodeReconciler{Client: fakeClient, Scheme: scheme}
+
+	resource := func(name string) lynqv1.TResource {
+		return lynqv1.TResource{
+			ID:           name,
+			NameTemplate: name,
+			Spec: unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "autoscaling/v2",
+				"kind":       "HorizontalPodAutoscaler",
+				"spec":       map[string]interface{}{"maxReplicas": int64(5)},
+			}},
+		}
+	}
+	vars, err := r.buildTemplateVariablesFromAnnotations(node)
+	require.NoError(t, err)
+	engine := template.NewEngine()
+
+	for _, name := range []string{"web", "shared", "missing"} {
+		obj, err := r.renderResource(ctx, engine, resource(name), vars, node)
+		require.NoError(t, err)
+		require.True(t, suspendedAutoscaler(obj, node))
+		require.NoError(t, r.removeSuspendedAutoscaler(ctx, obj, node))
+	}
+
+	// The node's own autoscaler is deleted, another owner's is kept
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "web", Namespace: "default"}, &autoscalingv2.HorizontalPodAutoscaler{})
+	assert.True(t, errors.IsNotFound(err))
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "shared", Namespace: "default"}, &autoscalingv2.HorizontalPodAutoscaler{}))
+
+	// A removed autoscaler does not make the suspended node unready
+	ready, failed, _ := r.checkResourcesReadiness(ctx, node, []lynqv1.TResource{resource("web")}, vars)
+	assert.Equal(t, int32(1), ready)
+	assert.Zero(t, failed)
+
+	// Active nodes keep their autoscalers
+	node.Annotations = nil
+	vars, err = r.buildTemplateVariablesFromAnnotations(node)
+	require.NoError(t, err)
+	obj, err := r.renderResource(ctx, engine, resource("web"), vars, node)
+	require.NoError(t, err)
+	assert.False(t, suspendedAutoscaler(obj, node))
+}
+
 // TestCleanupNodeResources tests resource cleanup with different deletion policies
 func TestCleanupNodeResources(t *testing.T) {
 	tests := []struct {
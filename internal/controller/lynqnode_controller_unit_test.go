@@ -140,6 +140,31 @@ func TestBuildTemplateVariablesFromAnnotations(t *testing.T) {
 	}
 }
 
+// TestBuildTemplateVariablesFromAnnotations_Typed tests that typed extra values keep their native types
+func TestBuildTemplateVariablesFromAnnotations_Typed(t *testing.T) {
+	node := &lynqv1.LynqNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				"lynq.sh/activate":    "1",
+				"lynq.sh/extra":       `{"plan":"premium","seats":"25","trial":""}`,
+				"lynq.sh/extra-types": `{"seats":"int","trial":"bool"}`,
+			},
+		},
+		Spec: lynqv1.LynqNodeSpec{UID: "node-typed"},
+	}
+
+	r := &LynqNodeReconciler{StatusManager: status.NewManager(nil, status.WithSyncMode())}
+	vars, err := r.buildTemplateVariablesFromAnnotations(node)
+	require.NoError(t, err)
+	assert.Equal(t, "premium", vars["plan"])
+	assert.Equal(t, int64(25), vars["seats"])
+	assert.Nil(t, vars["trial"], "NULL typed values become nil")
+
+	node.Annotations["lynq.sh/extra-types"] = `{broken`
+	_, err = r.buildTemplateVariablesFromAnnotations(node)
+	assert.Error(t, err)
+}
+
 // TestBuildResourceKey tests resource key generation
 func TestBuildResourceKey(t *testing.T) {
 	tests := []struct {
@@ -549,7 +574,7 @@ func TestRenderUnstructured_NoTemplates(t *testing.T) {
 	}
 	ctx := context.Background()
 	engine := template.NewEngine()
-	vars := template.BuildVariables("test-uid", "https://example.com", "true", map[string]string{})
+	vars := template.BuildVariables("test-uid", "https://example.com", "true", map[string]string{}, nil)
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
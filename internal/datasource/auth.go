@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AuthError reports that the data source rejected the configured credentials,
+// e.g. a wrong or rotated password or an expired bearer token.
+type AuthError struct {
+	Err error
+}
+
+func (e *AuthError) Error() string {
+	return "authentication failed: " + e.Err.Error()
+}
+
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// MySQL server errors caused by rejected credentials
+var mysqlAuthErrors = map[uint16]bool{
+	1045: true, // ER_ACCESS_DENIED_ERROR
+	1698: true, // ER_ACCESS_DENIED_NO_PASSWORD_ERROR
+	1862: true, // ER_MUST_CHANGE_PASSWORD_LOGIN
+	3118: true, // ER_ACCOUNT_HAS_BEEN_LOCKED
+}
+
+// wrapAuthError returns err as an *AuthError if it was caused by rejected credentials
+func wrapAuthError(err error) error {
+	var (
+		mysqlErr *mysql.MySQLError
+		pqErr    *pq.Error
+		authErr  *AuthError
+	)
+	switch {
+	case err == nil, errors.As(err, &authErr):
+		return err
+	case errors.As(err, &mysqlErr) && mysqlAuthErrors[mysqlErr.Number]:
+		return &AuthError{Err: err}
+	case errors.As(err, &pqErr) && pqErr.Code.Class() == "28":
+		// Class 28: invalid authorization specification (28000) and invalid password (28P01)
+		return &AuthError{Err: err}
+	case status.Code(err) == codes.Unauthenticated:
+		return &AuthError{Err: err}
+	}
+	return err
+}
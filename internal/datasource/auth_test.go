@@ -0,0 +1,56 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWrapAuthError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantAuth bool
+	}{
+		{name: "nil", err: nil},
+		{name: "mysql access denied", err: &mysql.MySQLError{Number: 1045, Message: "Access denied for user 'lynq'@'%'"}, wantAuth: true},
+		{name: "mysql unknown table", err: &mysql.MySQLError{Number: 1146, Message: "Table 'nodes' doesn't exist"}},
+		{name: "postgres invalid password", err: fmt.Errorf("ping: %w", &pq.Error{Code: "28P01"}), wantAuth: true},
+		{name: "postgres undefined table", err: &pq.Error{Code: "42P01"}},
+		{name: "plugin unauthenticated", err: status.Error(codes.Unauthenticated, "invalid token"), wantAuth: true},
+		{name: "plugin unavailable", err: status.Error(codes.Unavailable, "connection refused")},
+		{name: "already wrapped", err: &AuthError{Err: errors.New("401")}, wantAuth: true},
+		{name: "network error", err: errors.New("dial tcp: connection refused")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := wrapAuthError(tt.err)
+			var authErr *AuthError
+			assert.Equal(t, tt.wantAuth, errors.As(err, &authErr))
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Cache keeps one open Datasource per owner (e.g. a hub UID) so that connection
+// pools survive between syncs. A cached datasource is reused as long as the
+// connection config is unchanged; any change (including the password) replaces it.
+// Cache is safe for concurrent use.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+
+	// open creates a datasource (NewDatasource, replaceable in tests)
+	open func(SourceType, Config) (Datasource, error)
+}
+
+// cacheEntry is a cached datasource together with the hash of the config it was opened with
+type cacheEntry struct {
+	configHash string
+	ds         Datasource
+}
+
+// NewCache creates an empty datasource cache
+func NewCache() *Cache {
+	return &Cache{
+		entries: make(map[string]*cacheEntry),
+		open:    NewDatasource,
+	}
+}
+
+// Get returns the cached datasource for key, opening a new one if there is none
+// or the config changed since it was opened. A replaced datasource is closed.
+// The returned datasource is owned by the cache and must not be closed by the caller.
+// The cache is not locked while a datasource is opened, so a slow source only delays its own key.
+func (c *Cache) Get(key string, sourceType SourceType, config Config) (Datasource, error) {
+	hash, err := configHash(sourceType, config)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	stale, ok := c.entries[key]
+	if ok && stale.configHash == hash {
+		c.mu.Unlock()
+		return stale.ds, nil
+	}
+	// Config changed: drop the stale pool before opening a new one
+	delete(c.entries, key)
+	c.mu.Unlock()
+	if ok {
+		_ = stale.ds.Close() // Best effort close
+	}
+
+	ds, err := c.open(sourceType, config)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	existing, ok := c.entries[key]
+	if ok && existing.configHash == hash {
+		// Another caller opened the same config meanwhile: keep theirs
+		c.mu.Unlock()
+		_ = ds.Close() // Best effort close
+		return existing.ds, nil
+	}
+	c.entries[key] = &cacheEntry{configHash: hash, ds: ds}
+	c.mu.Unlock()
+	if ok {
+		_ = existing.ds.Close() // Best effort close
+	}
+	return ds, nil
+}
+
+// Invalidate closes and removes the datasource cached for key, if any
+func (c *Cache) Invalidate(key string) error {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	delete(c.entries, key)
+	c.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return entry.ds.Close()
+}
+
+// Prune closes and removes the datasources whose key starts with prefix,
+// except the keys in keep (e.g. the shards a federated hub still uses)
+func (c *Cache) Prune(prefix string, keep map[string]bool) error {
+	c.mu.Lock()
+	var pruned []*cacheEntry
+	for key, entry := range c.entries {
+		if strings.HasPrefix(key, prefix) && !keep[key] {
+			pruned = append(pruned, entry)
+			delete(c.entries, key)
+		}
+	}
+	c.mu.Unlock()
+
+	var errs []error
+	for _, entry := range pruned {
+		if err := entry.ds.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Len returns the number of cached datasources
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Close closes and removes all cached datasources
+func (c *Cache) Close() error {
+	c.mu.Lock()
+	entries := c.entries
+	c.entries = make(map[string]*cacheEntry)
+	c.mu.Unlock()
+
+	var errs []error
+	for key, entry := range entries {
+		if err := entry.ds.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close datasource %s: %w", key, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// configHash returns a hash identifying a connection config.
+// Only the hash is kept, so the password is never stored in the cache.
+func configHash(sourceType SourceType, config Config) (string, error) {
+	data, err := json.Marshal(struct {
+		Type   SourceType
+		Config Config
+	}{sourceType, config})
+	if err != nil {
+		return "", fmt.Errorf("failed to hash datasource config: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
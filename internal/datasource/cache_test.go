@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDatasource records whether it was closed
+type fakeDatasource struct {
+	closed bool
+}
+
+func (f *fakeDatasource) QueryNodes(ctx context.Context, config QueryConfig) ([]NodeRow, error) {
+	return nil, nil
+}
+
+func (f *fakeDatasource) Close() error {
+	f.closed = true
+	return nil
+}
+
+// newTestCache returns a cache whose datasources are fakes, and the list of opened fakes
+func newTestCache() (*Cache, *[]*fakeDatasource) {
+	opened := &[]*fakeDatasource{}
+	cache := NewCache()
+	cache.open = func(SourceType, Config) (Datasource, error) {
+		ds := &fakeDatasource{}
+		*opened = append(*opened, ds)
+		return ds, nil
+	}
+	return cache, opened
+}
+
+func TestCache_Get(t *testing.T) {
+	cache, opened := newTestCache()
+	config := Config{Host: "db", Port: 3306, Username: "reader", Password: "secret", Database: "nodes"}
+
+	first, err := cache.Get("hub-a", SourceTypeMySQL, config)
+	require.NoError(t, err)
+
+	// Same config reuses the open datasource
+	again, err := cache.Get("hub-a", SourceTypeMySQL, config)
+	require.NoError(t, err)
+	assert.Same(t, first, again)
+	assert.Len(t, *opened, 1)
+
+	// Different owner gets its own datasource
+	_, err = cache.Get("hub-b", SourceTypeMySQL, config)
+	require.NoError(t, err)
+	assert.Len(t, *opened, 2)
+	assert.Equal(t, 2, cache.Len())
+
+	// Password change replaces and closes the stale datasource
+	rotated := config
+	rotated.Password = "rotated"
+	replaced, err := cache.Get("hub-a", SourceTypeMySQL, rotated)
+	require.NoError(t, err)
+	assert.NotSame(t, first, replaced)
+	assert.True(t, (*opened)[0].closed)
+	assert.Len(t, *opened, 3)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestCache_GetDoesNotBlockOtherKeys(t *testing.T) {
+	release := make(chan struct{})
+	opening := make(chan struct{})
+	cache := NewCache()
+	cache.open = func(_ SourceType, config Config) (Datasource, error) {
+		if config.Host == "slow" {
+			close(opening)
+			<-release
+		}
+		return &fakeDatasource{}, nil
+	}
+
+	slow := make(chan Datasource)
+	go func() {
+		ds, _ := cache.Get("hub-slow", SourceTypeMySQL, Config{Host: "slow"})
+		slow <- ds
+	}()
+	<-opening
+
+	// Other hubs are served while the slow source is still connecting
+	_, err := cache.Get("hub-fast", SourceTypeMySQL, Config{Host: "fast"})
+	require.NoError(t, err)
+	require.NoError(t, cache.Invalidate("hub-other"))
+	assert.Equal(t, 1, cache.Len())
+
+	close(release)
+	assert.NotNil(t, <-slow)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestCache_GetConcurrentOpen(t *testing.T) {
+	cache, opened := newTestCache()
+	config := Config{Host: "db"}
+
+	// A datasource opened by another caller meanwhile is kept and the new one closed
+	existing := &fakeDatasource{}
+	open := cache.open
+	cache.open = func(sourceType SourceType, config Config) (Datasource, error) {
+		hash, err := configHash(sourceType, config)
+		require.NoError(t, err)
+		cache.mu.Lock()
+		cache.entries["hub-a"] = &cacheEntry{configHash: hash, ds: existing}
+		cache.mu.Unlock()
+		return open(sourceType, config)
+	}
+
+	ds, err := cache.Get("hub-a", SourceTypeMySQL, config)
+	require.NoError(t, err)
+	assert.Same(t, existing, ds)
+	require.Len(t, *opened, 1)
+	assert.True(t, (*opened)[0].closed)
+	assert.False(t, existing.closed)
+}
+
+func TestCache_GetOpenError(t *testing.T) {
+	cache := NewCache()
+	cache.open = func(SourceType, Config) (Datasource, error) {
+		return nil, errors.New("connection refused")
+	}
+
+	_, err := cache.Get("hub-a", SourceTypeMySQL, Config{})
+	assert.EqualError(t, err, "connection refused")
+	assert.Equal(t, 0, cache.Len(), "failed opens must not be cached")
+}
+
+func TestCache_InvalidateAndClose(t *testing.T) {
+	cache, opened := newTestCache()
+
+	_, err := cache.Get("hub-a", SourceTypeMySQL, Config{Host: "a"})
+	require.NoError(t, err)
+	_, err = cache.Get("hub-b", SourceTypeMySQL, Config{Host: "b"})
+	require.NoError(t, err)
+
+	require.NoError(t, cache.Invalidate("hub-a"))
+	assert.True(t, (*opened)[0].closed)
+	assert.False(t, (*opened)[1].closed)
+	assert.Equal(t, 1, cache.Len())
+
+	// Invalidating an unknown key is a no-op
+	require.NoError(t, cache.Invalidate("unknown"))
+
+	require.NoError(t, cache.Close())
+	assert.True(t, (*opened)[1].closed)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestCache_Prune(t *testing.T) {
+	cache, opened := newTestCache()
+
+	for _, key := range []string{"hub-a", "hub-a/eu", "hub-a/us", "hub-b"} {
+		_, err := cache.Get(key, SourceTypeMySQL, Config{Host: key})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, cache.Prune("hub-a", map[string]bool{"hub-a/eu": true}))
+	assert.True(t, (*opened)[0].closed)
+	assert.False(t, (*opened)[1].closed)
+	assert.True(t, (*opened)[2].closed)
+	assert.False(t, (*opened)[3].closed)
+	assert.Equal(t, 2, cache.Len())
+
+	require.NoError(t, cache.Prune("hub-a", nil))
+	assert.True(t, (*opened)[1].closed)
+	assert.Equal(t, 1, cache.Len())
+}
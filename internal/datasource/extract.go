@@ -0,0 +1,100 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ohler55/ojg/jp"
+)
+
+// extractExtraPaths replaces the extra values of config.ExtraPaths with the value their
+// JSONPath selects in the column's JSON document. Empty (NULL) columns and paths matching
+// nothing give an empty value. A column that is not valid JSON also gives an empty value and
+// is recorded in the row's ExtraErrors; an invalid path fails the whole query.
+func extractExtraPaths(rows []NodeRow, config QueryConfig) error {
+	if len(config.ExtraPaths) == 0 {
+		return nil
+	}
+
+	paths := make(map[string]jp.Expr, len(config.ExtraPaths))
+	for key, path := range config.ExtraPaths {
+		expr, err := jp.ParseString(path)
+		if err != nil {
+			return fmt.Errorf("extra value %s: invalid path %q: %w", key, path, err)
+		}
+		paths[key] = expr
+	}
+
+	for i := range rows {
+		row := &rows[i]
+
+		// Adapters may share one ExtraTypes map between rows, so each row gets its own copy
+		types := make(map[string]ValueType, len(row.ExtraTypes))
+		for key, valueType := range row.ExtraTypes {
+			if _, extracted := paths[key]; !extracted {
+				types[key] = valueType
+			}
+		}
+		row.ExtraTypes = types
+		if row.Extra == nil {
+			row.Extra = make(map[string]string)
+		}
+
+		for key, path := range paths {
+			raw := row.Extra[key]
+			// Typed values keep their type when empty, so they render as nil
+			setExtraValue(row, key, "", ValueTypeString, config)
+			if raw == "" {
+				continue
+			}
+			doc, err := decodeJSONValue(raw)
+			if err != nil {
+				if row.ExtraErrors == nil {
+					row.ExtraErrors = make(map[string]string)
+				}
+				row.ExtraErrors[key] = fmt.Sprintf("column %s is not valid JSON: %v", config.ExtraMappings[key], err)
+				continue
+			}
+			delete(row.ExtraTypes, key)
+			str, detected := jsonScalar(firstMatch(path, doc))
+			setExtraValue(row, key, str, detected, config)
+		}
+
+		if len(row.ExtraTypes) == 0 {
+			row.ExtraTypes = nil
+		}
+	}
+	return nil
+}
+
+// decodeJSONValue decodes a single JSON document, keeping numbers exact
+func decodeJSONValue(raw string) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	decoder.UseNumber()
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after the JSON document")
+	}
+	return doc, nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractExtraPaths(t *testing.T) {
+	config := QueryConfig{
+		ExtraMappings: map[string]string{"plan": "plan", "tier": "settings", "seats": "settings", "sso": "settings"},
+		ExtraTypes:    map[string]ValueType{"tier": ValueTypeAuto, "seats": ValueTypeAuto, "sso": ValueTypeBool},
+		ExtraPaths:    map[string]string{"tier": "$.plan.tier", "seats": "$.limits.seats", "sso": "$.features.sso"},
+	}
+	// SQL adapters share the resolved types between the rows of a page
+	shared := map[string]ValueType{"tier": ValueTypeJSON, "seats": ValueTypeJSON, "sso": ValueTypeBool}
+	settings := func(value string) map[string]string {
+		return map[string]string{"plan": "gold", "tier": value, "seats": value, "sso": value}
+	}
+	rows := []NodeRow{
+		{UID: "acme", Extra: settings(`{"plan":{"tier":"enterprise"},"limits":{"seats":25},"features":{"sso":true}}`), ExtraTypes: shared},
+		{UID: "beta", Extra: settings(`{"plan":{"tier":"free"}}`), ExtraTypes: shared},
+		{UID: "null", Extra: settings(""), ExtraTypes: shared},
+		{UID: "broken", Extra: settings(`{"plan":`), ExtraTypes: shared},
+	}
+
+	require.NoError(t, extractExtraPaths(rows, config))
+
+	assert.Equal(t, map[string]string{"plan": "gold", "tier": "enterprise", "seats": "25", "sso": "true"}, rows[0].Extra)
+	assert.Equal(t, map[string]ValueType{"seats": ValueTypeInt, "sso": ValueTypeBool}, rows[0].ExtraTypes)
+	assert.Nil(t, rows[0].ExtraErrors)
+
+	// Paths matching nothing give empty values; typed values stay typed so they render as nil
+	assert.Equal(t, map[string]string{"plan": "gold", "tier": "free", "seats": "", "sso": ""}, rows[1].Extra)
+	assert.Equal(t, map[string]ValueType{"sso": ValueTypeBool}, rows[1].ExtraTypes)
+
+	assert.Equal(t, map[string]string{"plan": "gold", "tier": "", "seats": "", "sso": ""}, rows[2].Extra)
+	assert.Nil(t, rows[2].ExtraErrors)
+
+	assert.Equal(t, map[string]string{"plan": "gold", "tier": "", "seats": "", "sso": ""}, rows[3].Extra)
+	require.Len(t, rows[3].ExtraErrors, 3)
+	assert.Contains(t, rows[3].ExtraErrors["tier"], "column settings is not valid JSON")
+
+	// The shared map is left untouched
+	assert.Equal(t, map[string]ValueType{"tier": ValueTypeJSON, "seats": ValueTypeJSON, "sso": ValueTypeBool}, shared)
+
+	// Trailing data is not a valid document
+	rows = []NodeRow{{UID: "acme", Extra: map[string]string{"tier": `{"plan":{"tier":"gold"}} {}`}}}
+	require.NoError(t, extractExtraPaths(rows, QueryConfig{ExtraPaths: map[string]string{"tier": "$.plan.tier"}}))
+	assert.Contains(t, rows[0].ExtraErrors, "tier")
+
+	assert.Error(t, extractExtraPaths(rows, QueryConfig{ExtraPaths: map[string]string{"tier": "$.["}}))
+}
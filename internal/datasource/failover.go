@@ -0,0 +1,140 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// minEndpointBackoff is how long an endpoint is skipped after its first failure
+	minEndpointBackoff = 10 * time.Second
+	// maxEndpointBackoff bounds the backoff of an endpoint that keeps failing
+	maxEndpointBackoff = 5 * time.Minute
+)
+
+// Endpoint is a database server address
+type Endpoint struct {
+	Host string
+	Port int32
+}
+
+// String returns host:port
+func (e Endpoint) String() string {
+	return net.JoinHostPort(e.Host, strconv.Itoa(int(e.Port)))
+}
+
+// endpointHealth tracks failed endpoints. A failed endpoint is skipped for a backoff that
+// doubles with every consecutive failure; a success resets it. The health of an endpoint
+// is shared by every datasource connecting to it with the same credentials (see
+// endpointHealthRegistry), so it survives datasources being reopened.
+type endpointHealth struct {
+	mu       sync.Mutex
+	failures map[string]endpointFailure
+
+	// now returns the current time (replaceable in tests)
+	now func() time.Time
+}
+
+// endpointFailure records the consecutive failures of an endpoint
+type endpointFailure struct {
+	count   int
+	retryAt time.Time
+}
+
+func newEndpointHealth() *endpointHealth {
+	return &endpointHealth{failures: make(map[string]endpointFailure), now: time.Now}
+}
+
+// endpointHealthRegistry holds one endpointHealth per credential set. Only errors of the
+// endpoint itself are recorded, but scoping the health keeps a hub whose credentials an
+// endpoint rejects in some other way from moving the other hubs off it.
+type endpointHealthRegistry struct {
+	mu     sync.Mutex
+	scopes map[string]*endpointHealth
+}
+
+// forCredentials returns the endpoint health shared by the datasources using the
+// username, password and database of config
+func (r *endpointHealthRegistry) forCredentials(config Config) *endpointHealth {
+	sum := sha256.Sum256([]byte(config.Username + "\x00" + config.Password + "\x00" + config.Database))
+	scope := hex.EncodeToString(sum[:])
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.scopes == nil {
+		r.scopes = make(map[string]*endpointHealth)
+	}
+	health, ok := r.scopes[scope]
+	if !ok {
+		health = newEndpointHealth()
+		r.scopes[scope] = health
+	}
+	return health
+}
+
+// mysqlEndpointHealth is the health of the MySQL endpoints of all hubs
+var mysqlEndpointHealth = &endpointHealthRegistry{}
+
+// order returns endpoints in the order they should be tried: the available ones in their
+// configured order, then the ones backing off, the soonest retry first
+func (h *endpointHealth) order(endpoints []Endpoint) []Endpoint {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	var available, backingOff []Endpoint
+	for _, endpoint := range endpoints {
+		if failure, ok := h.failures[endpoint.String()]; ok && now.Before(failure.retryAt) {
+			backingOff = append(backingOff, endpoint)
+		} else {
+			available = append(available, endpoint)
+		}
+	}
+	sort.SliceStable(backingOff, func(i, j int) bool {
+		return h.failures[backingOff[i].String()].retryAt.Before(h.failures[backingOff[j].String()].retryAt)
+	})
+	return append(available, backingOff...)
+}
+
+// failed records a failure of endpoint and extends its backoff
+func (h *endpointHealth) failed(endpoint Endpoint) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	failure := h.failures[endpoint.String()]
+	failure.count++
+	backoff := minEndpointBackoff << min(failure.count-1, 10)
+	if backoff > maxEndpointBackoff {
+		backoff = maxEndpointBackoff
+	}
+	failure.retryAt = h.now().Add(backoff)
+	h.failures[endpoint.String()] = failure
+}
+
+// succeeded clears the failures of endpoint
+func (h *endpointHealth) succeeded(endpoint Endpoint) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.failures, endpoint.String())
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointHealth(t *testing.T) {
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	health := newEndpointHealth()
+	health.now = func() time.Time { return now }
+
+	primary := Endpoint{Host: "db-0", Port: 3306}
+	replica1 := Endpoint{Host: "db-1", Port: 3306}
+	replica2 := Endpoint{Host: "db-2", Port: 3307}
+	endpoints := []Endpoint{primary, replica1, replica2}
+
+	assert.Equal(t, "db-2:3307", replica2.String())
+	assert.Equal(t, "[::1]:3306", Endpoint{Host: "::1", Port: 3306}.String())
+	assert.Equal(t, endpoints, health.order(endpoints))
+
+	// Failed endpoints move to the end, the soonest retry first
+	health.failed(primary)
+	health.failed(primary)
+	health.failed(replica1)
+	assert.Equal(t, []Endpoint{replica2, replica1, primary}, health.order(endpoints))
+	assert.Equal(t, now.Add(2*minEndpointBackoff), health.failures[primary.String()].retryAt)
+
+	// Once the backoff ends the endpoint is tried in its configured order again
+	now = now.Add(minEndpointBackoff)
+	assert.Equal(t, []Endpoint{replica1, replica2, primary}, health.order(endpoints))
+
+	health.succeeded(primary)
+	assert.Equal(t, endpoints, health.order(endpoints))
+
+	// The backoff is capped
+	for range 20 {
+		health.failed(replica2)
+	}
+	assert.Equal(t, now.Add(maxEndpointBackoff), health.failures[replica2.String()].retryAt)
+}
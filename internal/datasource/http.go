@@ -0,0 +1,497 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ohler55/ojg/jp"
+)
+
+const (
+	// defaultHTTPRowsPath selects a top-level JSON array
+	defaultHTTPRowsPath = "$"
+	// defaultHTTPMaxPages guards against endless pagination
+	defaultHTTPMaxPages = 1000
+	// maxHTTPResponseBytes limits the size of a single response page
+	maxHTTPResponseBytes = 32 << 20
+)
+
+// HTTP authentication types
+const (
+	HTTPAuthBearer = "bearer"
+	HTTPAuthBasic  = "basic"
+)
+
+// HTTP pagination types
+const (
+	HTTPPaginationNextLink = "nextLink"
+	HTTPPaginationCursor   = "cursor"
+)
+
+// HTTPConfig holds the settings of a JSON REST API source
+type HTTPConfig struct {
+	// URL is the endpoint queried with GET
+	URL string
+	// Headers are additional request headers
+	Headers map[string]string
+	// AuthType is HTTPAuthBearer (token in Config.Password), HTTPAuthBasic
+	// (Config.Username and Config.Password) or empty for no authentication
+	AuthType string
+	// RowsPath is a JSONPath selecting the row array in each response (default "$")
+	RowsPath string
+	// Pagination follows next links or cursors (nil reads a single page)
+	Pagination *HTTPPagination
+}
+
+// HTTPPagination configures how the next page of an HTTP source is requested
+type HTTPPagination struct {
+	// Type is HTTPPaginationNextLink or HTTPPaginationCursor
+	Type string
+	// NextLinkPath is a JSONPath to the next page URL (empty uses the Link header with rel="next")
+	NextLinkPath string
+	// CursorPath is a JSONPath to the cursor of the next page
+	CursorPath string
+	// CursorParam is the query parameter the cursor is sent in
+	CursorParam string
+	// MaxPages fails the query when more pages are returned (default 1000)
+	MaxPages int
+}
+
+// HTTPAdapter implements the Datasource interface for JSON REST APIs
+type HTTPAdapter struct {
+	client   *http.Client
+	baseURL  *url.URL
+	config   HTTPConfig
+	username string
+	secret   string
+
+	rowsPath     jp.Expr
+	nextLinkPath jp.Expr
+	cursorPath   jp.Expr
+	maxPages     int
+}
+
+// NewHTTPAdapter creates a new HTTP datasource adapter
+func NewHTTPAdapter(config Config) (*HTTPAdapter, error) {
+	if config.HTTP == nil {
+		return nil, fmt.Errorf("HTTP configuration is required")
+	}
+	httpConfig := *config.HTTP
+
+	baseURL, err := url.Parse(httpConfig.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid HTTP source URL: %w", err)
+	}
+	if (baseURL.Scheme != "http" && baseURL.Scheme != "https") || baseURL.Host == "" {
+		return nil, fmt.Errorf("invalid HTTP source URL %q: must be an absolute http or https URL", baseURL.Redacted())
+	}
+
+	adapter := &HTTPAdapter{
+		baseURL:  baseURL,
+		config:   httpConfig,
+		username: config.Username,
+		secret:   config.Password,
+		maxPages: defaultHTTPMaxPages,
+	}
+	if httpConfig.AuthType == HTTPAuthBearer {
+		// Tokens stored with "kubectl create secret --from-file" often end with a newline
+		adapter.secret = strings.TrimSpace(adapter.secret)
+	}
+
+	rowsPath := httpConfig.RowsPath
+	if rowsPath == "" {
+		rowsPath = defaultHTTPRowsPath
+	}
+	if adapter.rowsPath, err = jp.ParseString(rowsPath); err != nil {
+		return nil, fmt.Errorf("invalid rows path %q: %w", rowsPath, err)
+	}
+
+	if p := httpConfig.Pagination; p != nil {
+		switch p.Type {
+		case HTTPPaginationNextLink:
+			if p.NextLinkPath != "" {
+				if adapter.nextLinkPath, err = jp.ParseString(p.NextLinkPath); err != nil {
+					return nil, fmt.Errorf("invalid next link path %q: %w", p.NextLinkPath, err)
+				}
+			}
+		case HTTPPaginationCursor:
+			if p.CursorPath == "" || p.CursorParam == "" {
+				return nil, fmt.Errorf("cursor pagination requires a cursor path and a cursor parameter")
+			}
+			if adapter.cursorPath, err = jp.ParseString(p.CursorPath); err != nil {
+				return nil, fmt.Errorf("invalid cursor path %q: %w", p.CursorPath, err)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported pagination type: %s", p.Type)
+		}
+		if p.MaxPages > 0 {
+			adapter.maxPages = p.MaxPages
+		}
+	}
+
+	timeout := connectTimeout(config)
+	adapter.client = &http.Client{
+		Transport: &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			DialContext:         (&net.Dialer{Timeout: timeout}).DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConnsPerHost: 2,
+		},
+		// Do not forward credentials to another host
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return fmt.Errorf("stopped after 10 redirects")
+			}
+			if req.URL.Host != via[0].URL.Host {
+				req.Header.Del("Authorization")
+			}
+			return nil
+		},
+	}
+
+	return adapter, nil
+}
+
+// QueryNodes reads every page of the endpoint and maps the rows.
+// Filters and incremental queries are not supported by HTTP sources.
+func (a *HTTPAdapter) QueryNodes(ctx context.Context, config QueryConfig) ([]NodeRow, error) {
+	if len(config.Filters) > 0 {
+		return nil, fmt.Errorf("filters are not supported by http sources")
+	}
+	if config.ChangeTracking != nil && !config.ChangeTracking.Since.IsZero() {
+		return nil, fmt.Errorf("change tracking is not supported by http sources")
+	}
+
+	var nodes []NodeRow
+	pageURL := a.baseURL
+	visited := make(map[string]bool)
+	for page := 1; ; page++ {
+		if page > a.maxPages {
+			return nil, fmt.Errorf("pagination exceeded %d pages", a.maxPages)
+		}
+		visited[pageURL.String()] = true
+
+		doc, header, err := a.fetch(ctx, pageURL)
+		if err != nil {
+			return nil, err
+		}
+
+		items, err := a.rowItems(doc)
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", page, err)
+		}
+		for i, item := range items {
+			row, err := mapObjectRow(item, config)
+			if err != nil {
+				return nil, fmt.Errorf("page %d row %d: %w", page, i, err)
+			}
+			if config.IncludeInactive || config.ValueMappings.Activation.IsActive(row.Activate) {
+				nodes = append(nodes, row)
+			}
+		}
+
+		next, err := a.nextPage(pageURL, doc, header)
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", page, err)
+		}
+		if next == nil {
+			return nodes, nil
+		}
+		if visited[next.String()] {
+			return nil, fmt.Errorf("pagination loop: page %d links to an already visited page", page)
+		}
+		pageURL = next
+	}
+}
+
+// Close releases idle connections
+func (a *HTTPAdapter) Close() error {
+	a.client.CloseIdleConnections()
+	return nil
+}
+
+// fetch GETs a page and decodes its JSON body
+func (a *HTTPAdapter) fetch(ctx context.Context, pageURL *url.URL) (interface{}, http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL.String(), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	for name, value := range a.config.Headers {
+		req.Header.Set(name, value)
+	}
+	switch a.config.AuthType {
+	case HTTPAuthBearer:
+		req.Header.Set("Authorization", "Bearer "+a.secret)
+	case HTTPAuthBasic:
+		req.SetBasicAuth(a.username, a.secret)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query %s: %w", pageURL.Redacted(), err)
+	}
+	defer func() {
+		_ = resp.Body.Close() // Best effort close
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseBytes+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response from %s: %w", pageURL.Redacted(), err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		err := fmt.Errorf("GET %s returned %s: %s", pageURL.Redacted(), resp.Status, truncate(string(body), 200))
+		if resp.StatusCode == http.StatusUnauthorized {
+			err = &AuthError{Err: err}
+		}
+		return nil, nil, err
+	}
+	if len(body) > maxHTTPResponseBytes {
+		return nil, nil, fmt.Errorf("response from %s exceeds %d bytes", pageURL.Redacted(), maxHTTPResponseBytes)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, nil, fmt.Errorf("invalid JSON response from %s: %w", pageURL.Redacted(), err)
+	}
+	return doc, resp.Header, nil
+}
+
+// rowItems selects the rows of a response with the rows path.
+// A path matching a single array yields its elements, otherwise every match is a row.
+func (a *HTTPAdapter) rowItems(doc interface{}) ([]interface{}, error) {
+	matches := a.rowsPath.Get(doc)
+	if len(matches) == 0 {
+		// Treating a missing array as "no rows" would delete every node
+		return nil, fmt.Errorf("rows path %s matched nothing", a.rowsPath)
+	}
+	if len(matches) == 1 {
+		if items, ok := matches[0].([]interface{}); ok {
+			return items, nil
+		}
+	}
+	return matches, nil
+}
+
+// nextPage returns the URL of the page after current, or nil on the last page
+func (a *HTTPAdapter) nextPage(current *url.URL, doc interface{}, header http.Header) (*url.URL, error) {
+	p := a.config.Pagination
+	if p == nil {
+		return nil, nil
+	}
+
+	switch p.Type {
+	case HTTPPaginationCursor:
+		cursor, _ := jsonScalar(firstMatch(a.cursorPath, doc))
+		if cursor == "" {
+			return nil, nil
+		}
+		next := *a.baseURL
+		query := next.Query()
+		query.Set(p.CursorParam, cursor)
+		next.RawQuery = query.Encode()
+		return &next, nil
+
+	default:
+		var link string
+		if a.nextLinkPath != nil {
+			link, _ = jsonScalar(firstMatch(a.nextLinkPath, doc))
+		} else {
+			link = nextLinkFromHeader(header)
+		}
+		if link == "" {
+			return nil, nil
+		}
+		next, err := current.Parse(link)
+		if err != nil {
+			return nil, fmt.Errorf("invalid next link %q: %w", link, err)
+		}
+		// Credentials and headers are sent with every page, so pages must stay on the origin of the URL
+		if next.Scheme != a.baseURL.Scheme || !strings.EqualFold(next.Host, a.baseURL.Host) {
+			return nil, fmt.Errorf("next link %s is not on %s://%s", next.Redacted(), a.baseURL.Scheme, a.baseURL.Host)
+		}
+		return next, nil
+	}
+}
+
+// nextLinkFromHeader returns the rel="next" target of an RFC 8288 Link header
+func nextLinkFromHeader(header http.Header) string {
+	for _, value := range header.Values("Link") {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range parts[1:] {
+				name, val, ok := strings.Cut(strings.TrimSpace(param), "=")
+				if !ok || !strings.EqualFold(strings.TrimSpace(name), "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(val), `"`)) {
+					if strings.EqualFold(rel, "next") {
+						return strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// mapObjectRow maps a JSON object onto a node row (HTTP and Kubernetes sources).
+// Fields are top-level keys, or JSONPaths evaluated against the row when they start with "$".
+func mapObjectRow(item interface{}, config QueryConfig) (NodeRow, error) {
+	obj, ok := item.(map[string]interface{})
+	if !ok {
+		return NodeRow{}, fmt.Errorf("row is a %T, not a JSON object", item)
+	}
+
+	uid, err := objectField(obj, config.ValueMappings.UID)
+	if err != nil {
+		return NodeRow{}, err
+	}
+	activate, err := objectField(obj, config.ValueMappings.Activate)
+	if err != nil {
+		return NodeRow{}, err
+	}
+	row := NodeRow{Extra: make(map[string]string)}
+	row.UID, _ = jsonScalar(uid)
+	row.Activate, _ = jsonScalar(activate)
+	if config.ValueMappings.HostOrURL != "" {
+		hostOrURL, err := objectField(obj, config.ValueMappings.HostOrURL)
+		if err != nil {
+			return NodeRow{}, err
+		}
+		row.HostOrURL, _ = jsonScalar(hostOrURL)
+	}
+	if config.ValueMappings.ActiveFrom != "" {
+		activeFrom, err := objectField(obj, config.ValueMappings.ActiveFrom)
+		if err != nil {
+			return NodeRow{}, err
+		}
+		row.ActiveFrom, _ = jsonScalar(activeFrom)
+	}
+	if config.ValueMappings.ActiveUntil != "" {
+		activeUntil, err := objectField(obj, config.ValueMappings.ActiveUntil)
+		if err != nil {
+			return NodeRow{}, err
+		}
+		row.ActiveUntil, _ = jsonScalar(activeUntil)
+	}
+
+	for key, field := range config.ExtraMappings {
+		value, err := objectField(obj, field)
+		if err != nil {
+			return NodeRow{}, err
+		}
+		str, detected := jsonScalar(value)
+		setExtraValue(&row, key, str, detected, config)
+	}
+
+	return row, nil
+}
+
+// setExtraValue stores an extra value of a decoded row together with its resolved type.
+// detected is the type of the decoded value, used for ValueTypeAuto mappings.
+func setExtraValue(row *NodeRow, key, value string, detected ValueType, config QueryConfig) {
+	row.Extra[key] = value
+
+	valueType, typed := config.ExtraTypes[key]
+	if !typed {
+		return
+	}
+	if valueType == ValueTypeAuto {
+		valueType = detected
+	}
+	if valueType != ValueTypeString && valueType != "" {
+		if row.ExtraTypes == nil {
+			row.ExtraTypes = make(map[string]ValueType)
+		}
+		row.ExtraTypes[key] = valueType
+	}
+}
+
+// objectField returns the value of a field of a row (nil when missing)
+func objectField(obj map[string]interface{}, field string) (interface{}, error) {
+	if !strings.HasPrefix(field, "$") {
+		return obj[field], nil
+	}
+	path, err := jp.ParseString(field)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field path %q: %w", field, err)
+	}
+	return firstMatch(path, obj), nil
+}
+
+// firstMatch returns the first value matched by path, or nil
+func firstMatch(path jp.Expr, doc interface{}) interface{} {
+	matches := path.Get(doc)
+	if len(matches) == 0 {
+		return nil
+	}
+	return matches[0]
+}
+
+// jsonScalar converts a decoded JSON value to its string form and detected type.
+// null becomes "", objects and arrays are re-encoded as JSON.
+func jsonScalar(value interface{}) (string, ValueType) {
+	switch v := value.(type) {
+	case nil:
+		return "", ValueTypeString
+	case string:
+		return v, ValueTypeString
+	case bool:
+		return strconv.FormatBool(v), ValueTypeBool
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return v.String(), ValueTypeInt
+		}
+		return v.String(), ValueTypeFloat
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), ValueTypeFloat
+	case int64:
+		return strconv.FormatInt(v, 10), ValueTypeInt
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v), ValueTypeString
+		}
+		return string(data), ValueTypeJSON
+	}
+}
+
+// truncate shortens s to at most n bytes for error messages
+func truncate(s string, n int) string {
+	s = strings.TrimSpace(s)
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestHTTPAdapter creates an adapter for server with the given settings
+func newTestHTTPAdapter(t *testing.T, server *httptest.Server, path string, httpConfig HTTPConfig, username, secret string) *HTTPAdapter {
+	t.Helper()
+	httpConfig.URL = server.URL + path
+	adapter, err := NewHTTPAdapter(Config{Username: username, Password: secret, HTTP: &httpConfig})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = adapter.Close()
+	})
+	return adapter
+}
+
+var httpQueryConfig = QueryConfig{
+	ValueMappings: ValueMappings{UID: "id", Activate: "status", Activation: &ActivationRule{Values: []string{"active"}}},
+	ExtraMappings: map[string]string{"plan": "plan", "seats": "$.limits.seats", "owner": "$.owner.email"},
+	ExtraTypes:    map[string]ValueType{"seats": ValueTypeAuto},
+}
+
+func TestHTTPAdapter_NextLinkInBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprint(w, `{"data":{"items":[
+				{"id":"acme","status":"active","plan":"gold","limits":{"seats":25},"owner":{"email":"ops@acme.test"}},
+				{"id":"beta","status":"suspended","plan":"free","limits":{"seats":1}}
+			]},"links":{"next":"/tenants?page=2"}}`)
+		case "2":
+			fmt.Fprint(w, `{"data":{"items":[
+				{"id":42,"status":"active","plan":null,"limits":{"seats":2.5}}
+			]},"links":{"next":null}}`)
+		}
+	}))
+	defer server.Close()
+
+	adapter := newTestHTTPAdapter(t, server, "/tenants", HTTPConfig{
+		AuthType:   HTTPAuthBearer,
+		RowsPath:   "$.data.items",
+		Pagination: &HTTPPagination{Type: HTTPPaginationNextLink, NextLinkPath: "$.links.next"},
+	}, "", "s3cret")
+
+	rows, err := adapter.QueryNodes(context.Background(), httpQueryConfig)
+	require.NoError(t, err)
+	assert.Equal(t, []NodeRow{
+		{
+			UID:        "acme",
+			Activate:   "active",
+			Extra:      map[string]string{"plan": "gold", "seats": "25", "owner": "ops@acme.test"},
+			ExtraTypes: map[string]ValueType{"seats": ValueTypeInt},
+		},
+		{
+			UID:        "42",
+			Activate:   "active",
+			Extra:      map[string]string{"plan": "", "seats": "2.5", "owner": ""},
+			ExtraTypes: map[string]ValueType{"seats": ValueTypeFloat},
+		},
+	}, rows)
+}
+
+func TestHTTPAdapter_LinkHeaderAndBasicAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "lynq" || pass != "pw" || r.Header.Get("X-Tenant-Api") != "v2" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", `<http://`+r.Host+`/v2/tenants?page=2>; rel="next", <http://`+r.Host+`/v2/tenants?page=9>; rel="last"`)
+			fmt.Fprint(w, `[{"id":"a","status":"active"}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"id":"b","status":"active"}]`)
+	}))
+	defer server.Close()
+
+	adapter := newTestHTTPAdapter(t, server, "/v2/tenants", HTTPConfig{
+		AuthType:   HTTPAuthBasic,
+		Headers:    map[string]string{"X-Tenant-Api": "v2"},
+		Pagination: &HTTPPagination{Type: HTTPPaginationNextLink},
+	}, "lynq", "pw")
+
+	rows, err := adapter.QueryNodes(context.Background(), QueryConfig{
+		ValueMappings: ValueMappings{UID: "id", Activate: "status", Activation: &ActivationRule{Values: []string{"active"}}},
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "a", rows[0].UID)
+	assert.Equal(t, "b", rows[1].UID)
+}
+
+func TestHTTPAdapter_CrossOriginNextLink(t *testing.T) {
+	var leaked []string
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		leaked = append(leaked, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"items":[],"next":null}`)
+	}))
+	defer foreign.Close()
+
+	var next string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[{"id":"a","status":"active"}],"next":%q}`, next)
+	}))
+	defer server.Close()
+
+	adapter := newTestHTTPAdapter(t, server, "/tenants", HTTPConfig{
+		AuthType:   HTTPAuthBearer,
+		RowsPath:   "$.items",
+		Pagination: &HTTPPagination{Type: HTTPPaginationNextLink, NextLinkPath: "$.next"},
+	}, "", "s3cret")
+
+	for name, link := range map[string]string{
+		"other host":   foreign.URL + "/tenants?page=2",
+		"other scheme": "https://" + server.Listener.Addr().String() + "/tenants?page=2",
+	} {
+		t.Run(name, func(t *testing.T) {
+			next = link
+			_, err := adapter.QueryNodes(context.Background(), QueryConfig{
+				ValueMappings: ValueMappings{UID: "id", Activate: "status", Activation: &ActivationRule{Values: []string{"active"}}},
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "is not on")
+		})
+	}
+	assert.Empty(t, leaked, "credentials must not be sent to another origin")
+}
+
+func TestHTTPAdapter_Cursor(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		switch r.URL.Query().Get("after") {
+		case "":
+			fmt.Fprint(w, `{"rows":[{"id":"a","active":true}],"meta":{"next":"c1"}}`)
+		case "c1":
+			fmt.Fprint(w, `{"rows":[{"id":"b","active":false},{"id":"c","active":1}],"meta":{"next":""}}`)
+		}
+	}))
+	defer server.Close()
+
+	adapter := newTestHTTPAdapter(t, server, "/nodes?limit=2", HTTPConfig{
+		RowsPath:   "$.rows",
+		Pagination: &HTTPPagination{Type: HTTPPaginationCursor, CursorPath: "$.meta.next", CursorParam: "after"},
+	}, "", "")
+
+	rows, err := adapter.QueryNodes(context.Background(), QueryConfig{
+		ValueMappings: ValueMappings{UID: "id", Activate: "active"},
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2, "default truthy values apply to JSON booleans and numbers")
+	assert.Equal(t, "a", rows[0].UID)
+	assert.Equal(t, "c", rows[1].UID)
+	assert.Equal(t, []string{"limit=2", "after=c1&limit=2"}, requests)
+}
+
+func TestHTTPAdapter_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/unauthorized":
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+		case "/wrong-shape":
+			fmt.Fprint(w, `{"items":[]}`)
+		case "/not-objects":
+			fmt.Fprint(w, `["a","b"]`)
+		case "/loop":
+			fmt.Fprint(w, `{"rows":[],"next":"/loop"}`)
+		case "/endless":
+			fmt.Fprintf(w, `{"rows":[],"next":"/endless?n=%s1"}`, r.URL.Query().Get("n"))
+		case "/invalid":
+			fmt.Fprint(w, `{"rows":`)
+		}
+	}))
+	defer server.Close()
+
+	nextLink := &HTTPPagination{Type: HTTPPaginationNextLink, NextLinkPath: "$.next", MaxPages: 3}
+	tests := []struct {
+		name          string
+		path          string
+		config        HTTPConfig
+		queryConfig   QueryConfig
+		errorContains string
+	}{
+		{name: "non-2xx status", path: "/unauthorized", errorContains: "401 Unauthorized: invalid token"},
+		{name: "rows path matches nothing", path: "/wrong-shape", config: HTTPConfig{RowsPath: "$.data"}, errorContains: "matched nothing"},
+		{name: "rows are not objects", path: "/not-objects", errorContains: "not a JSON object"},
+		{name: "pagination loop", path: "/loop", config: HTTPConfig{RowsPath: "$.rows", Pagination: nextLink}, errorContains: "pagination loop"},
+		{name: "max pages", path: "/endless", config: HTTPConfig{RowsPath: "$.rows", Pagination: nextLink}, errorContains: "exceeded 3 pages"},
+		{name: "invalid JSON", path: "/invalid", errorContains: "invalid JSON response"},
+		{
+			name:          "filters unsupported",
+			path:          "/not-objects",
+			queryConfig:   QueryConfig{Filters: []FilterCondition{{Column: "region", Operator: FilterOperatorEquals, Values: []string{"eu"}}}},
+			errorContains: "filters are not supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter := newTestHTTPAdapter(t, server, tt.path, tt.config, "", "")
+			queryConfig := tt.queryConfig
+			queryConfig.ValueMappings = ValueMappings{UID: "id", Activate: "active"}
+			_, err := adapter.QueryNodes(context.Background(), queryConfig)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorContains)
+
+			// Rejected credentials are reported as authentication failures
+			var authErr *AuthError
+			assert.Equal(t, tt.path == "/unauthorized", errors.As(err, &authErr))
+		})
+	}
+}
+
+func TestNewHTTPAdapter_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+	}{
+		{name: "missing http config", config: Config{}},
+		{name: "relative URL", config: Config{HTTP: &HTTPConfig{URL: "/tenants"}}},
+		{name: "unsupported scheme", config: Config{HTTP: &HTTPConfig{URL: "ftp://example.com/tenants"}}},
+		{name: "invalid rows path", config: Config{HTTP: &HTTPConfig{URL: "https://example.com", RowsPath: "$.[["}}},
+		{
+			name: "cursor without parameter",
+			config: Config{HTTP: &HTTPConfig{
+				URL:        "https://example.com",
+				Pagination: &HTTPPagination{Type: HTTPPaginationCursor, CursorPath: "$.next"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewHTTPAdapter(tt.config)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNextLinkFromHeader(t *testing.T) {
+	header := http.Header{}
+	header.Add("Link", `<https://api.test/t?page=1>; rel="prev", <https://api.test/t?page=3>; rel="next"`)
+	assert.Equal(t, "https://api.test/t?page=3", nextLinkFromHeader(header))
+
+	header = http.Header{}
+	header.Add("Link", `<https://api.test/t?page=9>; rel="last"`)
+	assert.Empty(t, nextLinkFromHeader(header))
+	assert.Empty(t, nextLinkFromHeader(http.Header{}))
+}
@@ -18,8 +18,11 @@ package datasource
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 )
 
 // Datasource defines the interface that all datasource adapters must implement
@@ -31,6 +34,58 @@ type Datasource interface {
 	io.Closer
 }
 
+// PoolStatsProvider is implemented by datasources backed by a database/sql connection pool
+type PoolStatsProvider interface {
+	// PoolStats returns the current connection pool statistics
+	PoolStats() sql.DBStats
+}
+
+// StatusWriter is implemented by datasources that can write the provisioning status of nodes
+// back to the source table (status write-back)
+type StatusWriter interface {
+	// WriteStatus updates the status columns of the row of every status, committing one transaction
+	// per config.BatchSize statuses. It returns the number of statuses written by committed batches,
+	// also when a later batch failed.
+	WriteStatus(ctx context.Context, config WriteConfig, statuses []NodeStatus) (int, error)
+}
+
+// EndpointReporter is implemented by datasources that can be served by one of several endpoints
+type EndpointReporter interface {
+	// ServingEndpoint returns the host:port serving queries (empty when not connected)
+	// and whether it is a fallback endpoint rather than the configured host
+	ServingEndpoint() (endpoint string, fallback bool)
+}
+
+// Pager is implemented by datasources that can read nodes in pages ordered by UID
+// (keyset pagination), so that very large tables never have to be held in memory at once
+type Pager interface {
+	// QueryNodePage returns the active rows among the next config.PageSize rows with a UID
+	// greater than after (all rows when after is empty), ordered by UID.
+	// next is the cursor for the following page and is empty once the last page was read.
+	QueryNodePage(ctx context.Context, config QueryConfig, after string) (rows []NodeRow, next string, err error)
+}
+
+// QueryPage reads one page of nodes from ds and extracts the JSONPath extra values of
+// config.ExtraPaths. Datasources without Pager support, or a config without PageSize,
+// return every row in a single page.
+func QueryPage(ctx context.Context, ds Datasource, config QueryConfig, after string) ([]NodeRow, string, error) {
+	var rows []NodeRow
+	var next string
+	var err error
+	if pager, ok := ds.(Pager); ok && config.PageSize > 0 {
+		rows, next, err = pager.QueryNodePage(ctx, config, after)
+	} else {
+		rows, err = ds.QueryNodes(ctx, config)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if err := extractExtraPaths(rows, config); err != nil {
+		return nil, "", err
+	}
+	return rows, next, nil
+}
+
 // NodeRow represents a row from the node datasource
 type NodeRow struct {
 	UID string
@@ -39,6 +94,59 @@ type NodeRow struct {
 	HostOrURL string
 	Activate  string
 	Extra     map[string]string
+
+	// ExtraTypes holds the resolved type of typed extra values (key -> type)
+	// Keys without an entry are plain strings
+	ExtraTypes map[string]ValueType
+
+	// ExtraErrors holds the extra values that could not be extracted (key -> error)
+	// Those values are empty; the row is still synced
+	ExtraErrors map[string]string
+
+	// ActiveFrom and ActiveUntil are the raw values of the activation window columns
+	// (empty when unmapped or NULL). Adapters do not evaluate them; the caller does.
+	ActiveFrom  string
+	ActiveUntil string
+
+	// ChangedAt is the value of the change tracking column
+	// Only set when QueryConfig.ChangeTracking is used
+	ChangedAt time.Time
+
+	// Shard is the name of the shard the row was read from (federated hubs only).
+	// Set by the caller, not by adapters.
+	Shard string
+}
+
+// NodeStatus is the provisioning status of a node uid written back by a StatusWriter
+type NodeStatus struct {
+	UID            string
+	Ready          bool
+	Phase          string
+	Message        string
+	LastReconciled time.Time
+}
+
+// WriteConfig holds configuration for writing node status
+type WriteConfig struct {
+	// Table is the table updated by uid
+	Table string
+
+	// UIDColumn identifies the row of a status
+	UIDColumn string
+
+	// Columns are the status columns to write
+	Columns StatusColumns
+
+	// BatchSize is the number of statuses written per transaction (0 uses defaultStatusBatchSize)
+	BatchSize int
+}
+
+// StatusColumns names the columns written by a StatusWriter; empty columns are not written
+type StatusColumns struct {
+	Ready          string
+	Phase          string
+	Message        string
+	LastReconciled string
 }
 
 // QueryConfig holds configuration for querying nodes
@@ -46,11 +154,102 @@ type QueryConfig struct {
 	// Table/Collection name
 	Table string
 
+	// Query is an optional read-only SELECT used as the row source instead of Table
+	// Its result columns are mapped through ValueMappings and ExtraMappings like table columns
+	Query string
+
 	// Required column mappings
 	ValueMappings ValueMappings
 
 	// Extra column mappings
 	ExtraMappings map[string]string
+
+	// ExtraTypes sets the type of extra mappings (key -> type)
+	// Keys without an entry are plain strings; ValueTypeAuto derives the type from the column type
+	ExtraTypes map[string]ValueType
+
+	// ExtraPaths selects a nested value of JSON extra columns (key -> JSONPath)
+	// Applied by QueryPage; the value is typed like a decoded JSON field (see ExtraTypes)
+	ExtraPaths map[string]string
+
+	// Relations attach the child rows of related tables to each row (template key -> relation)
+	// Each relation becomes an extra value of type ValueTypeJSON holding a list of objects.
+	// Only supported by the SQL adapters; other adapters ignore it
+	Relations map[string]Relation
+
+	// Filters are pushed down into the WHERE clause and combined with AND
+	// Values are always passed as bound parameters
+	Filters []FilterCondition
+
+	// ChangeTracking enables incremental queries on a last-modified column (optional)
+	ChangeTracking *ChangeTracking
+
+	// PageSize is the number of rows read per page by Pager datasources (0 reads all rows at once)
+	// Rows with a NULL UID are skipped when paging
+	PageSize int
+
+	// IncludeInactive returns inactive rows as well, so incremental callers can
+	// remove nodes whose row was deactivated. Use ValueMappings.Activation.IsActive to tell them apart.
+	IncludeInactive bool
+}
+
+// Relation selects the child rows of a related table for each node row
+type Relation struct {
+	// Table is the child table
+	Table string
+	// ForeignKey is the child column holding the parent UID
+	ForeignKey string
+	// Columns maps item keys to child columns
+	Columns map[string]string
+	// OrderBy sorts the items (optional, defaults to the mapped columns in key order)
+	OrderBy string
+}
+
+// ValueType is the template type of an extra value
+type ValueType string
+
+const (
+	ValueTypeAuto   ValueType = "auto"
+	ValueTypeString ValueType = "string"
+	ValueTypeInt    ValueType = "int"
+	ValueTypeFloat  ValueType = "float"
+	ValueTypeBool   ValueType = "bool"
+	ValueTypeJSON   ValueType = "json"
+)
+
+// ChangeTracking configures incremental queries on a last-modified column
+type ChangeTracking struct {
+	// Column is the last-modified timestamp column (e.g. updated_at)
+	Column string
+
+	// Since restricts results to rows with Column >= Since
+	// The zero value reads all rows (used for full resyncs)
+	Since time.Time
+}
+
+// FilterOperator is a comparison operator used in a FilterCondition
+type FilterOperator string
+
+const (
+	FilterOperatorEquals             FilterOperator = "eq"
+	FilterOperatorNotEquals          FilterOperator = "ne"
+	FilterOperatorGreaterThan        FilterOperator = "gt"
+	FilterOperatorGreaterThanOrEqual FilterOperator = "gte"
+	FilterOperatorLessThan           FilterOperator = "lt"
+	FilterOperatorLessThanOrEqual    FilterOperator = "lte"
+	FilterOperatorIn                 FilterOperator = "in"
+	FilterOperatorNotIn              FilterOperator = "notIn"
+	FilterOperatorLike               FilterOperator = "like"
+	FilterOperatorIsNull             FilterOperator = "isNull"
+	FilterOperatorIsNotNull          FilterOperator = "isNotNull"
+)
+
+// FilterCondition is a single column predicate
+type FilterCondition struct {
+	Column   string
+	Operator FilterOperator
+	// Values holds the operands: none for isNull/isNotNull, one or more for in/notIn, exactly one otherwise
+	Values []string
 }
 
 // ValueMappings defines required column mappings
@@ -60,6 +259,41 @@ type ValueMappings struct {
 	// Use extraValueMappings with toHost() template function instead
 	HostOrURL string
 	Activate  string
+
+	// Activation decides which activate values are active (nil uses IsActive)
+	Activation *ActivationRule
+
+	// ActiveFrom and ActiveUntil are the optional activation window columns
+	ActiveFrom  string
+	ActiveUntil string
+}
+
+// ActivationRule matches activate column values against a set of values
+type ActivationRule struct {
+	// Values is the set of values the activate column is compared with
+	Values []string
+	// Negate activates rows whose value is not in Values
+	Negate bool
+	// CaseInsensitive compares values ignoring case
+	CaseInsensitive bool
+}
+
+// IsActive reports whether value marks a row as active under the rule.
+// A nil rule falls back to the default truthy values of the package-level IsActive.
+func (r *ActivationRule) IsActive(value string) bool {
+	if r == nil {
+		return IsActive(value)
+	}
+
+	value = strings.TrimSpace(value)
+	matched := false
+	for _, candidate := range r.Values {
+		if candidate == value || (r.CaseInsensitive && strings.EqualFold(candidate, value)) {
+			matched = true
+			break
+		}
+	}
+	return matched != r.Negate
 }
 
 // Config holds generic datasource configuration
@@ -72,10 +306,46 @@ type Config struct {
 	Password string
 	Database string
 
+	// PostgreSQL-specific fields
+	Schema  string // Schema containing the table (empty uses the server search_path)
+	SSLMode string // libpq sslmode (disable, require, verify-ca, verify-full)
+
+	// MySQL and plugin fields
+	TLS *TLSConfig // TLS/mTLS settings (nil uses a plain connection)
+
+	// MySQL-specific fields
+	Replicas []Endpoint // Fallback endpoints, tried in order when Host is unavailable
+
 	// Connection pool settings (optional, adapter-specific defaults will be used if not set)
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime string // Duration string (e.g., "5m")
+
+	// ConnectTimeout limits establishing a connection (optional, default 5s)
+	ConnectTimeout string // Duration string (e.g., "5s")
+
+	// HTTP-specific fields (Username and Password carry the credentials)
+	HTTP *HTTPConfig
+
+	// Kubernetes-specific fields
+	Kubernetes *KubernetesConfig
+
+	// Plugin-specific fields (Password carries the optional bearer token)
+	Plugin *PluginConfig
+}
+
+// TLSConfig holds TLS settings for a database connection.
+// Certificates and keys are PEM encoded.
+type TLSConfig struct {
+	// CA is the CA bundle used to verify the server (empty uses the system roots)
+	CA []byte
+	// ClientCert and ClientKey enable mutual TLS when both are set
+	ClientCert []byte
+	ClientKey  []byte
+	// ServerName overrides the host name used to verify the server certificate
+	ServerName string
+	// InsecureSkipVerify disables server certificate verification
+	InsecureSkipVerify bool
 }
 
 // SourceType represents the type of datasource
@@ -84,8 +354,14 @@ type SourceType string
 const (
 	// SourceTypeMySQL represents a MySQL datasource
 	SourceTypeMySQL SourceType = "mysql"
-	// SourceTypePostgreSQL represents a PostgreSQL datasource (planned)
+	// SourceTypePostgreSQL represents a PostgreSQL datasource
 	SourceTypePostgreSQL SourceType = "postgresql"
+	// SourceTypeHTTP represents a JSON REST API datasource
+	SourceTypeHTTP SourceType = "http"
+	// SourceTypeKubernetes represents rows stored in a ConfigMap, a Secret or the hub spec
+	SourceTypeKubernetes SourceType = "kubernetes"
+	// SourceTypePlugin represents an out-of-process datasource reached over gRPC
+	SourceTypePlugin SourceType = "plugin"
 )
 
 // NewDatasource creates a new datasource adapter based on the source type
@@ -94,7 +370,13 @@ func NewDatasource(sourceType SourceType, config Config) (Datasource, error) {
 	case SourceTypeMySQL:
 		return NewMySQLAdapter(config)
 	case SourceTypePostgreSQL:
-		return nil, fmt.Errorf("postgresql datasource not yet implemented (planned for v1.2)")
+		return NewPostgresAdapter(config)
+	case SourceTypeHTTP:
+		return NewHTTPAdapter(config)
+	case SourceTypeKubernetes:
+		return NewKubernetesAdapter(config)
+	case SourceTypePlugin:
+		return NewPluginAdapter(config)
 	default:
 		return nil, fmt.Errorf("unsupported datasource type: %s", sourceType)
 	}
@@ -17,9 +17,11 @@ limitations under the License.
 package datasource
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewDatasource(t *testing.T) {
@@ -43,7 +45,7 @@ func TestNewDatasource(t *testing.T) {
 			wantErr: true, // Will fail without real MySQL, but validates factory logic
 		},
 		{
-			name:       "postgresql datasource (not yet implemented)",
+			name:       "postgresql datasource",
 			sourceType: SourceTypePostgreSQL,
 			config: Config{
 				Host:     "localhost",
@@ -51,9 +53,10 @@ func TestNewDatasource(t *testing.T) {
 				Username: "postgres",
 				Password: "password",
 				Database: "nodes",
+				SSLMode:  "disable",
 			},
-			wantErr:    true,
-			errMessage: "postgresql datasource not yet implemented",
+			wantErr:    true, // Will fail without real PostgreSQL, but validates factory logic
+			errMessage: "failed to ping PostgreSQL",
 		},
 		{
 			name:       "unsupported datasource type",
@@ -159,3 +162,52 @@ func TestConfig(t *testing.T) {
 	assert.Equal(t, 10, config.MaxIdleConns)
 	assert.Equal(t, "10m", config.ConnMaxLifetime)
 }
+
+func TestActivationRule_IsActive(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  *ActivationRule
+		value string
+		want  bool
+	}{
+		{name: "nil rule uses default truthy values", rule: nil, value: "yes", want: true},
+		{name: "nil rule rejects status values", rule: nil, value: "active", want: false},
+		{name: "in matches", rule: &ActivationRule{Values: []string{"active", "trial"}}, value: "trial", want: true},
+		{name: "in does not match", rule: &ActivationRule{Values: []string{"active", "trial"}}, value: "suspended", want: false},
+		{name: "in is case sensitive by default", rule: &ActivationRule{Values: []string{"active"}}, value: "ACTIVE", want: false},
+		{name: "case insensitive", rule: &ActivationRule{Values: []string{"active"}, CaseInsensitive: true}, value: "ACTIVE", want: true},
+		{name: "padded value is trimmed", rule: &ActivationRule{Values: []string{"active"}}, value: "active  ", want: true},
+		{name: "notIn excludes listed values", rule: &ActivationRule{Values: []string{"suspended", "cancelled"}, Negate: true}, value: "cancelled", want: false},
+		{name: "notIn accepts other values", rule: &ActivationRule{Values: []string{"suspended", "cancelled"}, Negate: true}, value: "active", want: true},
+		{name: "NULL matches empty value", rule: &ActivationRule{Values: []string{""}, Negate: true}, value: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rule.IsActive(tt.value))
+		})
+	}
+}
+
+func TestQueryPage(t *testing.T) {
+	// Datasources without Pager support return everything in one page
+	rows, next, err := QueryPage(context.Background(), &fakeDatasource{}, QueryConfig{PageSize: 10}, "")
+	assert.NoError(t, err)
+	assert.Nil(t, rows)
+	assert.Empty(t, next)
+
+	// JSONPath extra values are extracted for every datasource
+	ds, err := NewKubernetesAdapter(Config{Kubernetes: &KubernetesConfig{
+		Format:  KubernetesFormatJSON,
+		Payload: []byte(`[{"id":"acme","active":true,"settings":"{\"tier\":\"gold\"}"}]`),
+	}})
+	require.NoError(t, err)
+	rows, _, err = QueryPage(context.Background(), ds, QueryConfig{
+		ValueMappings: ValueMappings{UID: "id", Activate: "active"},
+		ExtraMappings: map[string]string{"tier": "settings"},
+		ExtraPaths:    map[string]string{"tier": "$.tier"},
+	}, "")
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, map[string]string{"tier": "gold"}, rows[0].Extra)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Kubernetes payload formats
+const (
+	KubernetesFormatYAML = "yaml"
+	KubernetesFormatJSON = "json"
+	KubernetesFormatCSV  = "csv"
+)
+
+// KubernetesConfig holds the rows of a kubernetes source. The controller reads them
+// from a ConfigMap or Secret key, or encodes the inline rows of the hub as JSON.
+type KubernetesConfig struct {
+	// Format is KubernetesFormatYAML (default), KubernetesFormatJSON or KubernetesFormatCSV
+	Format string
+	// Payload is a list of row objects (YAML/JSON) or a CSV document with a header line
+	Payload []byte
+}
+
+// KubernetesAdapter implements the Datasource interface for rows stored in the cluster.
+// The payload is parsed once; the cache opens a new adapter when the payload changes.
+type KubernetesAdapter struct {
+	items []interface{}
+}
+
+// NewKubernetesAdapter creates a new Kubernetes datasource adapter
+func NewKubernetesAdapter(config Config) (*KubernetesAdapter, error) {
+	if config.Kubernetes == nil {
+		return nil, fmt.Errorf("kubernetes configuration is required")
+	}
+
+	items, err := parseRowPayload(config.Kubernetes.Format, config.Kubernetes.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return &KubernetesAdapter{items: items}, nil
+}
+
+// QueryNodes maps the rows of the payload.
+// Filters and incremental queries are not supported by Kubernetes sources.
+func (a *KubernetesAdapter) QueryNodes(ctx context.Context, config QueryConfig) ([]NodeRow, error) {
+	if len(config.Filters) > 0 {
+		return nil, fmt.Errorf("filters are not supported by kubernetes sources")
+	}
+	if config.ChangeTracking != nil && !config.ChangeTracking.Since.IsZero() {
+		return nil, fmt.Errorf("change tracking is not supported by kubernetes sources")
+	}
+
+	var nodes []NodeRow
+	for i, item := range a.items {
+		row, err := mapObjectRow(item, config)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
+		}
+		if config.IncludeInactive || config.ValueMappings.Activation.IsActive(row.Activate) {
+			nodes = append(nodes, row)
+		}
+	}
+	return nodes, nil
+}
+
+// Close is a no-op; the adapter holds no connections
+func (a *KubernetesAdapter) Close() error {
+	return nil
+}
+
+// parseRowPayload decodes a payload into row objects
+func parseRowPayload(format string, payload []byte) ([]interface{}, error) {
+	switch format {
+	case "", KubernetesFormatYAML:
+		data, err := yaml.YAMLToJSON(payload)
+		if err != nil {
+			return nil, fmt.Errorf("invalid YAML rows: %w", err)
+		}
+		return decodeJSONRows(data)
+	case KubernetesFormatJSON:
+		return decodeJSONRows(payload)
+	case KubernetesFormatCSV:
+		return parseCSVRows(payload)
+	default:
+		return nil, fmt.Errorf("unsupported rows format: %s", format)
+	}
+}
+
+// decodeJSONRows decodes a JSON array of row objects, keeping numbers exact
+func decodeJSONRows(data []byte) ([]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON rows: %w", err)
+	}
+	items, ok := doc.([]interface{})
+	if !ok {
+		// An empty or malformed payload must not be read as "no rows", which would delete every node
+		return nil, fmt.Errorf("rows must be a list of objects")
+	}
+	return items, nil
+}
+
+// parseCSVRows reads a CSV document whose first line names the columns.
+// All values are strings.
+func parseCSVRows(payload []byte) ([]interface{}, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(payload, []byte("\ufeff"))))
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV rows: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("CSV rows require a header line")
+	}
+
+	header := records[0]
+	seen := make(map[string]bool, len(header))
+	for i, name := range header {
+		header[i] = strings.TrimSpace(name)
+		if header[i] == "" || seen[header[i]] {
+			return nil, fmt.Errorf("CSV header column %d is empty or duplicated", i+1)
+		}
+		seen[header[i]] = true
+	}
+
+	items := make([]interface{}, 0, len(records)-1)
+	for _, record := range records[1:] {
+		obj := make(map[string]interface{}, len(header))
+		for i, name := range header {
+			obj[name] = record[i]
+		}
+		items = append(items, obj)
+	}
+	return items, nil
+}
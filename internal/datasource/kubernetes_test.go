@@ -0,0 +1,148 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var kubernetesQueryConfig = QueryConfig{
+	ValueMappings: ValueMappings{UID: "id", Activate: "active"},
+	ExtraMappings: map[string]string{"plan": "plan", "seats": "$.limits.seats"},
+	ExtraTypes:    map[string]ValueType{"seats": ValueTypeAuto},
+}
+
+func TestKubernetesAdapter_Formats(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		payload string
+		want    []NodeRow
+	}{
+		{
+			name:   "yaml",
+			format: KubernetesFormatYAML,
+			payload: `
+- id: acme
+  active: true
+  plan: gold
+  limits:
+    seats: 25
+- id: beta
+  active: false
+  plan: free
+`,
+			want: []NodeRow{{
+				UID:        "acme",
+				Activate:   "true",
+				Extra:      map[string]string{"plan": "gold", "seats": "25"},
+				ExtraTypes: map[string]ValueType{"seats": ValueTypeInt},
+			}},
+		},
+		{
+			name:    "json",
+			format:  KubernetesFormatJSON,
+			payload: `[{"id":"acme","active":"1","plan":"gold","limits":{"seats":2.5}}]`,
+			want: []NodeRow{{
+				UID:        "acme",
+				Activate:   "1",
+				Extra:      map[string]string{"plan": "gold", "seats": "2.5"},
+				ExtraTypes: map[string]ValueType{"seats": ValueTypeFloat},
+			}},
+		},
+		{
+			name:    "csv",
+			format:  KubernetesFormatCSV,
+			payload: "\ufeffid, active, plan\nacme, true, gold\nbeta, false, free\n",
+			want: []NodeRow{{
+				UID:      "acme",
+				Activate: "true",
+				Extra:    map[string]string{"plan": "gold", "seats": ""},
+			}},
+		},
+		{
+			name:    "empty list",
+			format:  KubernetesFormatYAML,
+			payload: "[]",
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter, err := NewKubernetesAdapter(Config{
+				Kubernetes: &KubernetesConfig{Format: tt.format, Payload: []byte(tt.payload)},
+			})
+			require.NoError(t, err)
+
+			rows, err := adapter.QueryNodes(context.Background(), kubernetesQueryConfig)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rows)
+		})
+	}
+}
+
+func TestKubernetesAdapter_InvalidPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		payload string
+		wantErr string
+	}{
+		{name: "empty yaml", format: KubernetesFormatYAML, payload: "", wantErr: "rows must be a list"},
+		{name: "yaml mapping", format: KubernetesFormatYAML, payload: "id: acme", wantErr: "rows must be a list"},
+		{name: "malformed json", format: KubernetesFormatJSON, payload: `[{"id":`, wantErr: "invalid JSON rows"},
+		{name: "empty csv", format: KubernetesFormatCSV, payload: "", wantErr: "header line"},
+		{name: "ragged csv", format: KubernetesFormatCSV, payload: "id,active\nacme\n", wantErr: "invalid CSV rows"},
+		{name: "duplicate csv header", format: KubernetesFormatCSV, payload: "id,id\na,b\n", wantErr: "empty or duplicated"},
+		{name: "unknown format", format: "toml", payload: "", wantErr: "unsupported rows format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKubernetesAdapter(Config{
+				Kubernetes: &KubernetesConfig{Format: tt.format, Payload: []byte(tt.payload)},
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	_, err := NewKubernetesAdapter(Config{})
+	assert.Error(t, err)
+}
+
+func TestKubernetesAdapter_RowErrors(t *testing.T) {
+	adapter, err := NewKubernetesAdapter(Config{
+		Kubernetes: &KubernetesConfig{Format: KubernetesFormatJSON, Payload: []byte(`[{"id":"a","active":true}, "b"]`)},
+	})
+	require.NoError(t, err)
+
+	_, err = adapter.QueryNodes(context.Background(), kubernetesQueryConfig)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "row 1")
+
+	_, err = adapter.QueryNodes(context.Background(), QueryConfig{
+		ValueMappings: kubernetesQueryConfig.ValueMappings,
+		Filters:       []FilterCondition{{Column: "plan", Operator: FilterOperatorEquals, Values: []string{"gold"}}},
+	})
+	assert.Error(t, err)
+}
@@ -18,205 +18,333 @@ package datasource
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
 	"fmt"
-	"sort"
-	"time"
+	"io"
+	"net"
+	"slices"
+	"strings"
+	"sync"
 
-	_ "github.com/go-sql-driver/mysql" // MySQL driver
+	"github.com/go-sql-driver/mysql"
 )
 
-// MySQLAdapter implements the Datasource interface for MySQL
+// MySQLAdapter implements the Datasource interface for MySQL.
+// With replicas it is served by the first endpoint that answers and fails over
+// to the next one when the serving endpoint stops answering.
 type MySQLAdapter struct {
-	db *sql.DB
+	mu       sync.Mutex
+	db       *sql.DB
+	endpoint Endpoint
+
+	// config and endpoints (Host first, then the replicas) are kept for reconnecting;
+	// endpoints is nil without replicas, which disables failover
+	config    Config
+	endpoints []Endpoint
+	health    *endpointHealth
+
+	// open connects to an endpoint (replaceable in tests)
+	open func(Config, Endpoint) (*sql.DB, error)
 }
 
 // NewMySQLAdapter creates a new MySQL datasource adapter
 func NewMySQLAdapter(config Config) (*MySQLAdapter, error) {
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true",
+	primary := Endpoint{Host: config.Host, Port: config.Port}
+	if len(config.Replicas) == 0 {
+		db, err := openMySQL(config, primary)
+		if err != nil {
+			return nil, err
+		}
+		return &MySQLAdapter{db: db, endpoint: primary}, nil
+	}
+
+	a := &MySQLAdapter{
+		config:    config,
+		endpoints: append([]Endpoint{primary}, config.Replicas...),
+		health:    mysqlEndpointHealth.forCredentials(config),
+		open:      openMySQL,
+	}
+	if err := a.connect(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// openMySQL opens a connection pool to endpoint and checks that the server answers
+func openMySQL(config Config, endpoint Endpoint) (*sql.DB, error) {
+	timeout := connectTimeout(config)
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true&timeout=%s",
 		config.Username,
 		config.Password,
-		config.Host,
-		config.Port,
+		endpoint.Host,
+		endpoint.Port,
 		config.Database,
+		timeout,
 	)
 
+	// Register a custom TLS config with the driver and reference it from the DSN
+	if config.TLS != nil {
+		name, err := registerMySQLTLSConfig(config.TLS, endpoint.Host)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MySQL TLS configuration: %w", err)
+		}
+		dsn += "&tls=" + name
+	}
+
 	db, err := sql.Open("mysql", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open MySQL connection: %w", err)
 	}
 
 	// Set connection pool settings
-	maxOpenConns := config.MaxOpenConns
-	if maxOpenConns == 0 {
-		maxOpenConns = 25 // Default
-	}
-	db.SetMaxOpenConns(maxOpenConns)
-
-	maxIdleConns := config.MaxIdleConns
-	if maxIdleConns == 0 {
-		maxIdleConns = 5 // Default
-	}
-	db.SetMaxIdleConns(maxIdleConns)
-
-	connMaxLifetime := 5 * time.Minute // Default
-	if config.ConnMaxLifetime != "" {
-		if parsed, err := time.ParseDuration(config.ConnMaxLifetime); err == nil {
-			connMaxLifetime = parsed
-		}
-	}
-	db.SetConnMaxLifetime(connMaxLifetime)
+	applyPoolSettings(db, config)
 
 	// Test connection
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	if err := db.PingContext(ctx); err != nil {
 		_ = db.Close() // Best effort close on error
+		if config.TLS != nil && (isTLSError(err) || errors.Is(err, mysql.ErrNoTLS)) {
+			err = &TLSError{Err: err}
+		}
+		err = wrapAuthError(err)
 		return nil, fmt.Errorf("failed to ping MySQL: %w", err)
 	}
 
-	return &MySQLAdapter{db: db}, nil
+	return db, nil
 }
 
-// QueryNodes queries active nodes from the MySQL database
-func (a *MySQLAdapter) QueryNodes(ctx context.Context, config QueryConfig) ([]NodeRow, error) {
-	// Build column list - start with required fields
-	columns := []string{
-		config.ValueMappings.UID,
+// connect opens a pool to the first endpoint that answers, in failover order.
+// Only endpoints that do not answer are marked failed; other errors, e.g. rejected
+// credentials or an unknown database, move on to the next endpoint without a backoff.
+// It must be called with a.mu held.
+func (a *MySQLAdapter) connect() error {
+	var errs []error
+	for _, endpoint := range a.health.order(a.endpoints) {
+		db, err := a.open(a.config, endpoint)
+		if err != nil {
+			if isEndpointFailure(err) {
+				a.health.failed(endpoint)
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", endpoint, err))
+			continue
+		}
+		a.health.succeeded(endpoint)
+		a.db, a.endpoint = db, endpoint
+		return nil
 	}
+	return fmt.Errorf("all %d MySQL endpoints failed: %w", len(a.endpoints), errors.Join(errs...))
+}
 
-	// Add HostOrURL only if specified (deprecated, optional since v1.1.11)
-	includeHostOrURL := config.ValueMappings.HostOrURL != ""
-	if includeHostOrURL {
-		columns = append(columns, config.ValueMappings.HostOrURL)
+// failBack switches to the first endpoint in failover order when it precedes the serving
+// one, e.g. to Host once its backoff has ended and it answers again.
+// It must be called with a.mu held.
+func (a *MySQLAdapter) failBack() {
+	preferred := a.health.order(a.endpoints)[0]
+	if a.db != nil && slices.Index(a.endpoints, preferred) >= slices.Index(a.endpoints, a.endpoint) {
+		return
 	}
-
-	// Add activate column
-	columns = append(columns, config.ValueMappings.Activate)
-
-	// Add extra columns in sorted order for stable queries
-	// Sort the keys to ensure consistent column order
-	extraKeys := make([]string, 0, len(config.ExtraMappings))
-	for key := range config.ExtraMappings {
-		extraKeys = append(extraKeys, key)
+	db, err := a.open(a.config, preferred)
+	if err != nil {
+		if isEndpointFailure(err) {
+			a.health.failed(preferred)
+		}
+		return
 	}
-	sort.Strings(extraKeys)
-
-	extraColumns := make([]string, 0, len(config.ExtraMappings))
-	for _, key := range extraKeys {
-		col := config.ExtraMappings[key]
-		columns = append(columns, col)
-		extraColumns = append(extraColumns, col)
+	a.health.succeeded(preferred)
+	if a.db != nil {
+		_ = a.db.Close()
 	}
+	a.db, a.endpoint = db, preferred
+}
 
-	// Build query
-	query := fmt.Sprintf("SELECT %s FROM %s", joinColumns(columns), config.Table)
+// withFailover runs query against the serving endpoint. With replicas it first fails back
+// to a preferred endpoint (when failBack is set), and when the serving endpoint stops
+// answering it marks it failed, connects to the next endpoint and runs query again.
+func (a *MySQLAdapter) withFailover(ctx context.Context, failBack bool, query func(db *sql.DB) error) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 
-	// Execute query
-	rows, err := a.db.QueryContext(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query nodes: %w", err)
+	if a.endpoints == nil {
+		return query(a.db)
 	}
-	defer func() {
-		_ = rows.Close() // Best effort close
-	}()
-
-	// Scan results
-	var nodes []NodeRow
-	for rows.Next() {
-		row := NodeRow{
-			Extra: make(map[string]string),
+	if failBack {
+		a.failBack()
+	}
+	if a.db == nil {
+		if err := a.connect(); err != nil {
+			return err
 		}
+	}
 
-		// Use NullString for required fields to handle NULL values
-		var uid, hostOrURL, activate sql.NullString
-
-		// Prepare scan destinations based on which columns were queried
-		scanDest := []interface{}{&uid}
-		if includeHostOrURL {
-			scanDest = append(scanDest, &hostOrURL)
+	for attempt := 1; ; attempt++ {
+		err := query(a.db)
+		if err == nil || attempt == len(a.endpoints) || ctx.Err() != nil || !isEndpointFailure(err) {
+			return err
 		}
-		scanDest = append(scanDest, &activate)
-
-		// Add extra column destinations
-		extraValues := make([]sql.NullString, len(extraColumns))
-		for i := range extraValues {
-			scanDest = append(scanDest, &extraValues[i])
+		a.health.failed(a.endpoint)
+		_ = a.db.Close()
+		a.db = nil
+		if connectErr := a.connect(); connectErr != nil {
+			return fmt.Errorf("%w; failover: %w", err, connectErr)
 		}
+	}
+}
 
-		if err := rows.Scan(scanDest...); err != nil {
-			return nil, fmt.Errorf("failed to scan row: %w", err)
-		}
+// isEndpointFailure reports whether err means the endpoint stopped answering (or did not
+// answer a connection check in time), as opposed to an error of the query or the
+// credentials, which every endpoint would return
+func isEndpointFailure(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == 1053 // ER_SERVER_SHUTDOWN
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, mysql.ErrInvalidConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
 
-		// Convert NullString to string (NULL becomes empty string)
-		if uid.Valid {
-			row.UID = uid.String
-		}
-		if hostOrURL.Valid {
-			row.HostOrURL = hostOrURL.String
-		}
-		if activate.Valid {
-			row.Activate = activate.String
-		}
+// ServingEndpoint returns the endpoint serving queries and whether it is a replica
+func (a *MySQLAdapter) ServingEndpoint() (string, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 
-		// Map extra values - Build column index map first for stable mapping
-		colIndex := make(map[string]int)
-		for i, col := range extraColumns {
-			colIndex[col] = i
-		}
+	if a.db == nil || a.endpoint.Host == "" {
+		return "", false
+	}
+	return a.endpoint.String(), a.endpoints != nil && a.endpoint != a.endpoints[0]
+}
 
-		// Map extra values using stable indices
-		for key, col := range config.ExtraMappings {
-			idx, ok := colIndex[col]
-			if !ok {
-				// Column not in result set (shouldn't happen)
-				row.Extra[key] = ""
-				continue
-			}
-			if extraValues[idx].Valid {
-				row.Extra[key] = extraValues[idx].String
-			} else {
-				row.Extra[key] = "" // Null values become empty strings
-			}
-		}
+// QueryNodes queries active nodes from the MySQL database
+func (a *MySQLAdapter) QueryNodes(ctx context.Context, config QueryConfig) ([]NodeRow, error) {
+	nodes, _, err := a.queryNodes(ctx, config, nil)
+	return nodes, err
+}
 
-		// Filter: only include active nodes
-		// Note: HostOrURL is deprecated since v1.1.11 and no longer required
-		if isActive(row.Activate) {
-			nodes = append(nodes, row)
-		}
+// QueryNodePage queries one keyset page of active nodes ordered by the UID column
+func (a *MySQLAdapter) QueryNodePage(ctx context.Context, config QueryConfig, after string) ([]NodeRow, string, error) {
+	if config.PageSize <= 0 {
+		return nil, "", fmt.Errorf("page size must be greater than zero")
 	}
+	return a.queryNodes(ctx, config, &pageRequest{after: after, size: config.PageSize})
+}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating rows: %w", err)
+// queryNodes reads node rows and attaches their child rows
+func (a *MySQLAdapter) queryNodes(ctx context.Context, config QueryConfig, page *pageRequest) ([]NodeRow, string, error) {
+	var nodes []NodeRow
+	var next string
+	// Fail back only at the start of a sync, so the pages of one sync come from one endpoint
+	err := a.withFailover(ctx, page == nil || page.after == "", func(db *sql.DB) error {
+		var err error
+		nodes, next, err = mysqlDialect.queryNodeRows(ctx, db, config, qualifyMySQLTable(config.Table), page)
+		if err != nil {
+			return err
+		}
+		return mysqlDialect.attachRelations(ctx, db, config, nodes, qualifyMySQLTable)
+	})
+	if err != nil {
+		return nil, "", err
 	}
+	return nodes, next, nil
+}
 
-	return nodes, nil
+// WriteStatus writes node status to the table of config, which may be qualified with a database (db.table).
+// Status is only written to Host; while a replica serves the source the write fails.
+func (a *MySQLAdapter) WriteStatus(ctx context.Context, config WriteConfig, statuses []NodeStatus) (int, error) {
+	var written int
+	err := a.withFailover(ctx, false, func(db *sql.DB) error {
+		if a.endpoints != nil && a.endpoint != a.endpoints[0] {
+			return fmt.Errorf("status is only written to %s, the source is served by replica %s",
+				a.endpoints[0], a.endpoint)
+		}
+		var err error
+		written, err = mysqlDialect.writeStatus(ctx, db, qualifyMySQLTable(config.Table), config, statuses)
+		return err
+	})
+	return written, err
 }
 
 // Close closes the database connection
 func (a *MySQLAdapter) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
 	if a.db != nil {
 		return a.db.Close()
 	}
 	return nil
 }
 
+// PoolStats returns the connection pool statistics
+func (a *MySQLAdapter) PoolStats() sql.DBStats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.db == nil {
+		return sql.DBStats{}
+	}
+	return a.db.Stats()
+}
+
 // Helper functions
 
-func joinColumns(columns []string) string {
-	result := ""
-	for i, col := range columns {
-		if i > 0 {
-			result += ", "
-		}
-		result += "`" + col + "`" // Escape column names
+// registerMySQLTLSConfig registers a TLS config with the MySQL driver and returns its name.
+// The name is derived from the TLS material, so identical configs share one registration
+// and re-registering after a certificate rotation never affects other hubs.
+func registerMySQLTLSConfig(config *TLSConfig, host string) (string, error) {
+	tlsConfig, err := buildTLSConfig(config, host)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	for _, part := range [][]byte{config.CA, config.ClientCert, config.ClientKey, []byte(tlsConfig.ServerName)} {
+		_, _ = fmt.Fprintf(h, "%d:%s|", len(part), part)
+	}
+	_, _ = fmt.Fprintf(h, "%t", config.InsecureSkipVerify)
+	name := "lynq-" + hex.EncodeToString(h.Sum(nil))[:16]
+
+	if err := mysql.RegisterTLSConfig(name, tlsConfig); err != nil {
+		return "", fmt.Errorf("failed to register TLS config: %w", err)
 	}
-	return result
+	return name, nil
+}
+
+// mysqlDialect uses backtick-quoted identifiers and ? placeholders
+var mysqlDialect = sqlDialect{
+	quoteIdentifier: quoteMySQLIdentifier,
+	placeholder:     func(int) string { return "?" },
+}
+
+// qualifyMySQLTable quotes a table name that may be qualified with a database (db.table)
+func qualifyMySQLTable(table string) string {
+	parts := strings.Split(table, ".")
+	for i, part := range parts {
+		parts[i] = quoteMySQLIdentifier(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// quoteMySQLIdentifier quotes an identifier with backticks, doubling any embedded backticks
+func quoteMySQLIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func joinColumns(columns []string) string {
+	return mysqlDialect.joinColumns(columns)
 }
 
-func isActive(value string) bool {
+// IsActive reports whether an activate column value is truthy
+func IsActive(value string) bool {
 	// Truthy values: "1", "true", "TRUE", "yes", etc.
 	switch value {
 	case "1", "true", "TRUE", "True", "yes", "YES", "Yes":
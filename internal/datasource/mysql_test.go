@@ -19,9 +19,16 @@ package datasource
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -134,6 +141,81 @@ func TestMySQLAdapter_QueryNodes(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "activation rule selects rows by status value",
+			queryConfig: QueryConfig{
+				Table: "nodes",
+				ValueMappings: ValueMappings{
+					UID:        "id",
+					Activate:   "status",
+					Activation: &ActivationRule{Values: []string{"active", "trial"}},
+				},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "status"}).
+					AddRow("node1", "active").
+					AddRow("node2", "suspended").
+					AddRow("node3", "trial").
+					AddRow("node4", "1")
+				mock.ExpectQuery("SELECT .* FROM .*").
+					WillReturnRows(rows)
+			},
+			want: []NodeRow{
+				{UID: "node1", Activate: "active", Extra: map[string]string{}},
+				{UID: "node3", Activate: "trial", Extra: map[string]string{}},
+			},
+		},
+		{
+			name: "typed extra values resolve auto types from column types",
+			queryConfig: QueryConfig{
+				Table: "nodes",
+				ValueMappings: ValueMappings{
+					UID:      "id",
+					Activate: "active",
+				},
+				ExtraMappings: map[string]string{
+					"config": "settings",
+					"plan":   "plan_name",
+					"seats":  "seat_count",
+					"trial":  "is_trial",
+				},
+				ExtraTypes: map[string]ValueType{
+					"config": ValueTypeJSON,
+					"plan":   ValueTypeAuto,
+					"seats":  ValueTypeAuto,
+					"trial":  ValueTypeBool,
+				},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := mock.NewRowsWithColumnDefinition(
+					mock.NewColumn("id").OfType("VARCHAR", ""),
+					mock.NewColumn("active").OfType("TINYINT", 0),
+					mock.NewColumn("settings").OfType("JSON", ""),
+					mock.NewColumn("plan_name").OfType("VARCHAR", ""),
+					mock.NewColumn("seat_count").OfType("INT", 0),
+					mock.NewColumn("is_trial").OfType("TINYINT", 0),
+				).AddRow("node1", "1", `{"tier":"gold"}`, "premium", "25", "0")
+				mock.ExpectQuery("SELECT .* FROM .*").
+					WillReturnRows(rows)
+			},
+			want: []NodeRow{
+				{
+					UID:      "node1",
+					Activate: "1",
+					Extra: map[string]string{
+						"config": `{"tier":"gold"}`,
+						"plan":   "premium",
+						"seats":  "25",
+						"trial":  "0",
+					},
+					ExtraTypes: map[string]ValueType{
+						"config": ValueTypeJSON,
+						"seats":  ValueTypeInt,
+						"trial":  ValueTypeBool,
+					},
+				},
+			},
+		},
 		{
 			name: "query with NULL values",
 			queryConfig: QueryConfig{
@@ -263,6 +345,163 @@ func TestMySQLAdapter_QueryNodes(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "query with filters pushed into WHERE clause",
+			queryConfig: QueryConfig{
+				Table: "nodes",
+				ValueMappings: ValueMappings{
+					UID:      "id",
+					Activate: "active",
+				},
+				Filters: []FilterCondition{
+					{Column: "region", Operator: FilterOperatorEquals, Values: []string{"us-east-1"}},
+					{Column: "cluster", Operator: FilterOperatorIn, Values: []string{"prod-a", "prod-b"}},
+				},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "active"}).
+					AddRow("node1", "1")
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT `id`, `active` FROM `nodes` WHERE `region` = ? AND `cluster` IN (?, ?)")).
+					WithArgs("us-east-1", "prod-a", "prod-b").
+					WillReturnRows(rows)
+			},
+			want: []NodeRow{
+				{
+					UID:      "node1",
+					Activate: "1",
+					Extra:    map[string]string{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "change tracking returns changed rows including inactive ones",
+			queryConfig: QueryConfig{
+				Table: "nodes",
+				ValueMappings: ValueMappings{
+					UID:      "id",
+					Activate: "active",
+				},
+				ExtraMappings: map[string]string{
+					"planId": "plan",
+				},
+				Filters: []FilterCondition{
+					{Column: "region", Operator: FilterOperatorEquals, Values: []string{"eu"}},
+				},
+				ChangeTracking: &ChangeTracking{
+					Column: "updated_at",
+					Since:  time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+				},
+				IncludeInactive: true,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "active", "plan", "updated_at"}).
+					AddRow("node1", "1", "premium", time.Date(2025, 6, 1, 12, 5, 0, 0, time.UTC)).
+					AddRow("node2", "0", "basic", time.Date(2025, 6, 1, 12, 1, 0, 0, time.UTC))
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT `id`, `active`, `plan`, `updated_at` FROM `nodes` WHERE `region` = ? AND `updated_at` >= ?")).
+					WithArgs("eu", time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)).
+					WillReturnRows(rows)
+			},
+			want: []NodeRow{
+				{
+					UID:       "node1",
+					Activate:  "1",
+					Extra:     map[string]string{"planId": "premium"},
+					ChangedAt: time.Date(2025, 6, 1, 12, 5, 0, 0, time.UTC),
+				},
+				{
+					UID:       "node2",
+					Activate:  "0",
+					Extra:     map[string]string{"planId": "basic"},
+					ChangedAt: time.Date(2025, 6, 1, 12, 1, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "activation window columns are selected before the change tracking column",
+			queryConfig: QueryConfig{
+				Table: "nodes",
+				ValueMappings: ValueMappings{
+					UID:         "id",
+					Activate:    "active",
+					ActiveFrom:  "starts_at",
+					ActiveUntil: "trial_ends_at",
+				},
+				ExtraMappings:  map[string]string{"planId": "plan"},
+				ChangeTracking: &ChangeTracking{Column: "updated_at"},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "active", "plan", "starts_at", "trial_ends_at", "updated_at"}).
+					AddRow("node1", "1", "trial", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), nil,
+						time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC))
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT `id`, `active`, `plan`, `starts_at`, `trial_ends_at`, `updated_at` FROM `nodes`")).
+					WillReturnRows(rows)
+			},
+			want: []NodeRow{
+				{
+					UID:        "node1",
+					Activate:   "1",
+					Extra:      map[string]string{"planId": "trial"},
+					ActiveFrom: "2025-06-01T00:00:00Z",
+					ChangedAt:  time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "custom query is wrapped as a derived table",
+			queryConfig: QueryConfig{
+				Query: "SELECT t.id, t.active, p.name AS plan FROM tenants t JOIN plans p ON p.id = t.plan_id;",
+				ValueMappings: ValueMappings{
+					UID:      "id",
+					Activate: "active",
+				},
+				ExtraMappings: map[string]string{
+					"planId": "plan",
+				},
+				Filters: []FilterCondition{
+					{Column: "plan", Operator: FilterOperatorNotEquals, Values: []string{"free"}},
+				},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "active", "plan"}).
+					AddRow("node1", "1", "premium")
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT `id`, `active`, `plan` FROM " +
+						"(SELECT t.id, t.active, p.name AS plan FROM tenants t JOIN plans p ON p.id = t.plan_id) AS `lynq_source` " +
+						"WHERE `plan` <> ?")).
+					WithArgs("free").
+					WillReturnRows(rows)
+			},
+			want: []NodeRow{
+				{
+					UID:      "node1",
+					Activate: "1",
+					Extra: map[string]string{
+						"planId": "premium",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid filter",
+			queryConfig: QueryConfig{
+				Table: "nodes",
+				ValueMappings: ValueMappings{
+					UID:      "id",
+					Activate: "active",
+				},
+				Filters: []FilterCondition{
+					{Column: "region", Operator: FilterOperatorIn},
+				},
+			},
+			setupMock:     func(mock sqlmock.Sqlmock) {},
+			wantErr:       true,
+			errorContains: "invalid filter",
+		},
 		{
 			name: "database query error",
 			queryConfig: QueryConfig{
@@ -338,6 +577,298 @@ func TestMySQLAdapter_QueryNodes(t *testing.T) {
 	}
 }
 
+func TestMySQLAdapter_QueryNodePage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	config := QueryConfig{
+		Table:         "nodes",
+		ValueMappings: ValueMappings{UID: "id", Activate: "active"},
+		Filters:       []FilterCondition{{Column: "region", Operator: FilterOperatorEquals, Values: []string{"eu"}}},
+		PageSize:      2,
+	}
+
+	// First page: full page, the cursor is the last scanned UID even if that row is inactive
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT `id`, `active` FROM `nodes` WHERE `region` = ? AND `id` IS NOT NULL ORDER BY `id` LIMIT 2")).
+		WithArgs("eu").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "active"}).
+			AddRow("node1", "1").
+			AddRow("node2", "0"))
+	// Second page: short page ends the iteration
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT `id`, `active` FROM `nodes` WHERE `region` = ? AND `id` > ? ORDER BY `id` LIMIT 2")).
+		WithArgs("eu", "node2").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "active"}).
+			AddRow("node3", "true"))
+
+	adapter := &MySQLAdapter{db: db}
+	ctx := context.Background()
+
+	rows, next, err := adapter.QueryNodePage(ctx, config, "")
+	require.NoError(t, err)
+	assert.Equal(t, []NodeRow{{UID: "node1", Activate: "1", Extra: map[string]string{}}}, rows)
+	assert.Equal(t, "node2", next)
+
+	rows, next, err = adapter.QueryNodePage(ctx, config, next)
+	require.NoError(t, err)
+	assert.Equal(t, []NodeRow{{UID: "node3", Activate: "true", Extra: map[string]string{}}}, rows)
+	assert.Empty(t, next)
+
+	_, _, err = adapter.QueryNodePage(ctx, QueryConfig{Table: "nodes"}, "")
+	assert.Error(t, err, "paging requires a page size")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLAdapter_QueryNodesWithRelations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	// The main table is quoted like relation tables, so reserved words and hyphens work
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `active`, `seats` FROM `crm`.`tenant-nodes`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "active", "seats"}).
+			AddRow("acme", "1", "10").
+			AddRow("beta", "1", "2"))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT `tenant_id`, `domain`, `is_primary` FROM `crm`.`tenant_domains` WHERE `tenant_id` IN (?, ?) "+
+			"ORDER BY `tenant_id`, `domain`, `is_primary`")).
+		WithArgs("acme", "beta").
+		WillReturnRows(sqlmock.NewRows([]string{"tenant_id", "domain", "is_primary"}).
+			AddRow("acme", "acme.example.com", "1").
+			AddRow("acme", "www.acme.example.com", nil))
+
+	adapter := &MySQLAdapter{db: db}
+	rows, err := adapter.QueryNodes(context.Background(), QueryConfig{
+		Table:         "crm.tenant-nodes",
+		ValueMappings: ValueMappings{UID: "id", Activate: "active"},
+		ExtraMappings: map[string]string{"seats": "seats"},
+		ExtraTypes:    map[string]ValueType{"seats": ValueTypeInt},
+		Relations: map[string]Relation{
+			"domains": {
+				Table:      "crm.tenant_domains",
+				ForeignKey: "tenant_id",
+				Columns:    map[string]string{"host": "domain", "primary": "is_primary"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	types := map[string]ValueType{"seats": ValueTypeInt, "domains": ValueTypeJSON}
+	assert.Equal(t, []NodeRow{
+		{
+			UID:      "acme",
+			Activate: "1",
+			Extra: map[string]string{
+				"seats":   "10",
+				"domains": `[{"host":"acme.example.com","primary":"1"},{"host":"www.acme.example.com","primary":""}]`,
+			},
+			ExtraTypes: types,
+		},
+		{
+			UID:        "beta",
+			Activate:   "1",
+			Extra:      map[string]string{"seats": "2", "domains": `[]`},
+			ExtraTypes: types,
+		},
+	}, rows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLAdapter_WriteStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	update := regexp.QuoteMeta(
+		"UPDATE `crm`.`tenants` SET `provisioned` = ?, `lynq_phase` = ?, `lynq_synced_at` = ? WHERE `id` = ?")
+
+	// First batch commits, the second fails and is rolled back
+	mock.ExpectBegin()
+	prepared := mock.ExpectPrepare(update)
+	prepared.ExpectExec().WithArgs(true, "Ready", now, "acme").WillReturnResult(sqlmock.NewResult(0, 1))
+	prepared.ExpectExec().WithArgs(false, "Failed", now, "beta").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectPrepare(update).ExpectExec().WithArgs(false, "Progressing", now, "gamma").
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	adapter := &MySQLAdapter{db: db}
+	written, err := adapter.WriteStatus(context.Background(), WriteConfig{
+		Table:     "crm.tenants",
+		UIDColumn: "id",
+		Columns:   StatusColumns{Ready: "provisioned", Phase: "lynq_phase", LastReconciled: "lynq_synced_at"},
+		BatchSize: 2,
+	}, []NodeStatus{
+		{UID: "acme", Ready: true, Phase: "Ready", LastReconciled: now},
+		{UID: "beta", Phase: "Failed", Message: "1 resources failed", LastReconciled: now},
+		{UID: "gamma", Phase: "Progressing", LastReconciled: now},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gamma")
+	assert.Equal(t, 2, written)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	_, err = adapter.WriteStatus(context.Background(), WriteConfig{Table: "tenants", UIDColumn: "id"}, nil)
+	assert.Error(t, err)
+}
+
+func TestMySQLAdapter_Failover(t *testing.T) {
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	health := newEndpointHealth()
+	health.now = func() time.Time { return now }
+
+	primary := Endpoint{Host: "db-0", Port: 3306}
+	replica1 := Endpoint{Host: "db-1", Port: 3306}
+	replica2 := Endpoint{Host: "db-2", Port: 3306}
+
+	dbs := make(map[Endpoint]*sql.DB)
+	mocks := make(map[Endpoint]sqlmock.Sqlmock)
+	for _, endpoint := range []Endpoint{primary, replica1, replica2} {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		dbs[endpoint], mocks[endpoint] = db, mock
+	}
+	down := map[Endpoint]bool{primary: true}
+	adapter := &MySQLAdapter{
+		endpoints: []Endpoint{primary, replica1, replica2},
+		health:    health,
+		open: func(_ Config, endpoint Endpoint) (*sql.DB, error) {
+			if down[endpoint] {
+				return nil, &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+			}
+			return dbs[endpoint], nil
+		},
+	}
+
+	// The primary is down, the first replica serves
+	require.NoError(t, adapter.connect())
+	endpoint, fallback := adapter.ServingEndpoint()
+	assert.Equal(t, "db-1:3306", endpoint)
+	assert.True(t, fallback)
+
+	query := regexp.QuoteMeta("SELECT `id`, `active` FROM `nodes`")
+	config := QueryConfig{Table: "nodes", ValueMappings: ValueMappings{UID: "id", Activate: "active"}}
+	want := []NodeRow{{UID: "acme", Activate: "1", Extra: map[string]string{}}}
+
+	// Status is only written to the primary
+	_, err := adapter.WriteStatus(context.Background(), WriteConfig{
+		Table: "nodes", UIDColumn: "id", Columns: StatusColumns{Phase: "phase"},
+	}, []NodeStatus{{UID: "acme", Phase: "Ready"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only written to db-0:3306")
+
+	// Query errors do not fail over
+	mocks[replica1].ExpectQuery(query).WillReturnError(&mysql.MySQLError{Number: 1146, Message: "no such table"})
+	_, err = adapter.QueryNodes(context.Background(), config)
+	require.Error(t, err)
+	endpoint, _ = adapter.ServingEndpoint()
+	assert.Equal(t, "db-1:3306", endpoint)
+
+	// A lost connection fails over to the next replica and the query is retried
+	mocks[replica1].ExpectQuery(query).WillReturnError(&net.OpError{Op: "read", Err: errors.New("connection reset")})
+	mocks[replica2].ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"id", "active"}).AddRow("acme", "1"))
+	rows, err := adapter.QueryNodes(context.Background(), config)
+	require.NoError(t, err)
+	assert.Equal(t, want, rows)
+	endpoint, fallback = adapter.ServingEndpoint()
+	assert.Equal(t, "db-2:3306", endpoint)
+	assert.True(t, fallback)
+
+	// Once its backoff ended, the next sync fails back to the primary
+	now = now.Add(maxEndpointBackoff)
+	down[primary] = false
+	mocks[primary].ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"id", "active"}).AddRow("acme", "1"))
+	rows, err = adapter.QueryNodes(context.Background(), config)
+	require.NoError(t, err)
+	assert.Equal(t, want, rows)
+	endpoint, fallback = adapter.ServingEndpoint()
+	assert.Equal(t, "db-0:3306", endpoint)
+	assert.False(t, fallback)
+
+	for _, mock := range mocks {
+		assert.NoError(t, mock.ExpectationsWereMet())
+	}
+
+	// Without any endpoint answering, the errors of all endpoints are reported
+	down = map[Endpoint]bool{primary: true, replica1: true, replica2: true}
+	adapter.db = nil
+	err = adapter.connect()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "all 3 MySQL endpoints failed")
+	assert.Contains(t, err.Error(), "db-2:3306: dial: connection refused")
+}
+
+func TestMySQLAdapter_FailoverCredentialErrors(t *testing.T) {
+	registry := &endpointHealthRegistry{}
+	primary := Endpoint{Host: "db-0", Port: 3306}
+	replica := Endpoint{Host: "db-1", Port: 3306}
+	endpoints := []Endpoint{primary, replica}
+
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	wrong := Config{Username: "lynq", Password: "wrong", Database: "tenants"}
+	right := Config{Username: "lynq", Password: "s3cret", Database: "tenants"}
+	newAdapter := func(config Config) *MySQLAdapter {
+		return &MySQLAdapter{
+			config:    config,
+			endpoints: endpoints,
+			health:    registry.forCredentials(config),
+			open: func(config Config, endpoint Endpoint) (*sql.DB, error) {
+				if config.Password != "s3cret" {
+					return nil, wrapAuthError(&mysql.MySQLError{Number: 1045, Message: "Access denied"})
+				}
+				return db, nil
+			},
+		}
+	}
+
+	// A rejected password fails the sync of its hub without marking the endpoints failed
+	rejected := newAdapter(wrong)
+	err = rejected.connect()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Access denied")
+	assert.Empty(t, rejected.health.failures)
+
+	// Another hub on the same hosts keeps the primary first
+	healthy := newAdapter(right)
+	assert.Equal(t, endpoints, healthy.health.order(endpoints))
+	require.NoError(t, healthy.connect())
+	endpoint, fallback := healthy.ServingEndpoint()
+	assert.Equal(t, "db-0:3306", endpoint)
+	assert.False(t, fallback)
+
+	// Endpoint failures are scoped to the credentials too
+	rejected.health.failed(primary)
+	assert.Equal(t, endpoints, healthy.health.order(endpoints))
+	assert.Same(t, healthy.health, registry.forCredentials(right))
+}
+
+func TestIsEndpointFailure(t *testing.T) {
+	assert.True(t, isEndpointFailure(driver.ErrBadConn))
+	assert.True(t, isEndpointFailure(fmt.Errorf("failed to query nodes: %w", mysql.ErrInvalidConn)))
+	assert.True(t, isEndpointFailure(&net.OpError{Op: "dial", Err: errors.New("connection refused")}))
+	assert.True(t, isEndpointFailure(&mysql.MySQLError{Number: 1053}))
+	assert.True(t, isEndpointFailure(fmt.Errorf("failed to ping MySQL: %w", context.DeadlineExceeded)))
+	assert.False(t, isEndpointFailure(&mysql.MySQLError{Number: 1146}))
+	assert.False(t, isEndpointFailure(&AuthError{Err: &mysql.MySQLError{Number: 1045}}))
+	assert.False(t, isEndpointFailure(errors.New("syntax error")))
+}
+
 func TestJoinColumns(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -364,6 +895,11 @@ func TestJoinColumns(t *testing.T) {
 			columns: []string{"user.id", "node-name"},
 			want:    "`user.id`, `node-name`",
 		},
+		{
+			name:    "embedded backticks are doubled",
+			columns: []string{"bad`name"},
+			want:    "`bad``name`",
+		},
 	}
 
 	for _, tt := range tests {
@@ -401,7 +937,7 @@ func TestIsActive(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := isActive(tt.value)
+			got := IsActive(tt.value)
 			assert.Equal(t, tt.want, got)
 		})
 	}
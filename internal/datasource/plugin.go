@@ -0,0 +1,231 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	datasourcev1 "github.com/k8s-lynq/lynq/proto/lynq/datasource/v1"
+)
+
+// The plugin contract is the lynq.datasource.v1.Datasource service in
+// proto/lynq/datasource/v1/datasource.proto. It has a single unary RPC,
+// QueryNodes, which returns one page of rows. Plugins return inactive rows
+// too; the operator applies the hub's activation rule.
+const (
+	// maxPluginMessageBytes limits the size of a single response
+	maxPluginMessageBytes = 64 << 20
+	// defaultPluginMaxPages guards against a plugin that never returns an empty cursor
+	defaultPluginMaxPages = 100000
+)
+
+// PluginConfig holds the settings of a gRPC datasource plugin.
+// The optional bearer token is Config.Password and TLS is Config.TLS (nil uses plaintext).
+type PluginConfig struct {
+	// Endpoint is the gRPC target (e.g. localhost:9000 or dns:///plugin.tools.svc:9000)
+	Endpoint string
+	// Options are sent with every request
+	Options map[string]string
+}
+
+// PluginAdapter implements the Datasource interface by calling a gRPC plugin
+type PluginAdapter struct {
+	conn    *grpc.ClientConn
+	client  datasourcev1.DatasourceClient
+	options map[string]string
+	token   string
+}
+
+// NewPluginAdapter creates a new gRPC plugin datasource adapter.
+// The connection is established lazily by the first query.
+func NewPluginAdapter(config Config) (*PluginAdapter, error) {
+	if config.Plugin == nil || config.Plugin.Endpoint == "" {
+		return nil, fmt.Errorf("plugin endpoint is required")
+	}
+
+	creds := insecure.NewCredentials()
+	if config.TLS != nil {
+		tlsConfig, err := buildTLSConfig(config.TLS, pluginHost(config.Plugin.Endpoint))
+		if err != nil {
+			return nil, fmt.Errorf("invalid plugin TLS configuration: %w", err)
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.NewClient(config.Plugin.Endpoint,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxPluginMessageBytes)),
+		grpc.WithConnectParams(grpc.ConnectParams{MinConnectTimeout: connectTimeout(config)}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plugin endpoint %q: %w", config.Plugin.Endpoint, err)
+	}
+
+	return &PluginAdapter{
+		conn:    conn,
+		client:  datasourcev1.NewDatasourceClient(conn),
+		options: config.Plugin.Options,
+		token:   strings.TrimSpace(config.Password),
+	}, nil
+}
+
+// QueryNodes reads all rows from the plugin, following its cursors
+func (a *PluginAdapter) QueryNodes(ctx context.Context, config QueryConfig) ([]NodeRow, error) {
+	var nodes []NodeRow
+	after := ""
+	for page := 1; ; page++ {
+		if page > defaultPluginMaxPages {
+			return nil, fmt.Errorf("plugin pagination exceeded %d pages", defaultPluginMaxPages)
+		}
+		rows, next, err := a.QueryNodePage(ctx, config, after)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, rows...)
+		if next == "" {
+			return nodes, nil
+		}
+		if next == after {
+			return nil, fmt.Errorf("plugin returned the same cursor %q twice", next)
+		}
+		after = next
+	}
+}
+
+// QueryNodePage reads one page of rows from the plugin.
+// Unlike the SQL adapters it also accepts PageSize 0, which asks the plugin for all rows.
+func (a *PluginAdapter) QueryNodePage(ctx context.Context, config QueryConfig, after string) ([]NodeRow, string, error) {
+	if a.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+a.token)
+	}
+
+	resp, err := a.client.QueryNodes(ctx, a.pluginRequest(config, after))
+	if err != nil {
+		return nil, "", fmt.Errorf("plugin query failed: %w", wrapAuthError(err))
+	}
+
+	return decodePluginResponse(resp, config)
+}
+
+// Close closes the plugin connection
+func (a *PluginAdapter) Close() error {
+	return a.conn.Close()
+}
+
+// pluginRequest builds the request message for a query
+func (a *PluginAdapter) pluginRequest(config QueryConfig, after string) *datasourcev1.QueryNodesRequest {
+	filters := make([]*datasourcev1.Filter, 0, len(config.Filters))
+	for _, filter := range config.Filters {
+		filters = append(filters, &datasourcev1.Filter{
+			Column:   filter.Column,
+			Operator: string(filter.Operator),
+			Values:   filter.Values,
+		})
+	}
+
+	req := &datasourcev1.QueryNodesRequest{
+		Options: a.options,
+		ValueMappings: &datasourcev1.ValueMappings{
+			Uid:         config.ValueMappings.UID,
+			HostOrUrl:   config.ValueMappings.HostOrURL,
+			Activate:    config.ValueMappings.Activate,
+			ActiveFrom:  config.ValueMappings.ActiveFrom,
+			ActiveUntil: config.ValueMappings.ActiveUntil,
+		},
+		ExtraMappings: config.ExtraMappings,
+		Filters:       filters,
+		PageSize:      int32(config.PageSize),
+		After:         after,
+	}
+	if tracking := config.ChangeTracking; tracking != nil {
+		req.ChangeTracking = &datasourcev1.ChangeTracking{Column: tracking.Column}
+		if !tracking.Since.IsZero() {
+			req.ChangeTracking.Since = timestamppb.New(tracking.Since)
+		}
+	}
+	return req
+}
+
+// decodePluginResponse maps the rows of a plugin response and returns the next cursor
+func decodePluginResponse(resp *datasourcev1.QueryNodesResponse, config QueryConfig) ([]NodeRow, string, error) {
+	var nodes []NodeRow
+	for i, item := range resp.GetRows() {
+		row := NodeRow{
+			UID:         item.GetUid(),
+			Activate:    item.GetActivate(),
+			HostOrURL:   item.GetHostOrUrl(),
+			ActiveFrom:  item.GetActiveFrom(),
+			ActiveUntil: item.GetActiveUntil(),
+			Extra:       make(map[string]string),
+		}
+
+		for key := range config.ExtraMappings {
+			str, detected := pluginValue(item.GetExtra()[key])
+			setExtraValue(&row, key, str, detected, config)
+		}
+
+		if changedAt := item.GetChangedAt(); changedAt != nil {
+			if err := changedAt.CheckValid(); err != nil {
+				return nil, "", fmt.Errorf("plugin row %d: invalid changedAt: %w", i, err)
+			}
+			row.ChangedAt = changedAt.AsTime()
+		}
+
+		if config.IncludeInactive || config.ValueMappings.Activation.IsActive(row.Activate) {
+			nodes = append(nodes, row)
+		}
+	}
+	return nodes, resp.GetNext(), nil
+}
+
+// pluginValue converts an extra value like jsonScalar. An unset value is an empty string.
+func pluginValue(value *datasourcev1.Value) (string, ValueType) {
+	switch kind := value.GetKind().(type) {
+	case *datasourcev1.Value_StringValue:
+		return jsonScalar(kind.StringValue)
+	case *datasourcev1.Value_IntValue:
+		return jsonScalar(kind.IntValue)
+	case *datasourcev1.Value_FloatValue:
+		return jsonScalar(kind.FloatValue)
+	case *datasourcev1.Value_BoolValue:
+		return jsonScalar(kind.BoolValue)
+	case *datasourcev1.Value_JsonValue:
+		return kind.JsonValue, ValueTypeJSON
+	default:
+		return jsonScalar(nil)
+	}
+}
+
+// pluginHost returns the host of a gRPC target for TLS server name verification
+func pluginHost(endpoint string) string {
+	if _, rest, ok := strings.Cut(endpoint, ":///"); ok {
+		endpoint = rest
+	}
+	if host, _, err := net.SplitHostPort(endpoint); err == nil {
+		return host
+	}
+	return endpoint
+}
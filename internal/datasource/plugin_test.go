@@ -0,0 +1,203 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	datasourcev1 "github.com/k8s-lynq/lynq/proto/lynq/datasource/v1"
+)
+
+// fakePlugin serves two pages of rows and records the requests it received
+type fakePlugin struct {
+	datasourcev1.UnimplementedDatasourceServer
+	requests []*datasourcev1.QueryNodesRequest
+	tokens   []string
+}
+
+func (p *fakePlugin) QueryNodes(ctx context.Context, req *datasourcev1.QueryNodesRequest) (*datasourcev1.QueryNodesResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	p.tokens = append(p.tokens, md.Get("authorization")...)
+	p.requests = append(p.requests, req)
+
+	if req.GetOptions()["collection"] != "tenants" {
+		return nil, status.Error(codes.NotFound, "unknown collection")
+	}
+	if req.GetAfter() == "" {
+		return &datasourcev1.QueryNodesResponse{
+			Rows: []*datasourcev1.Row{
+				{
+					Uid:      "acme",
+					Activate: "true",
+					Extra: map[string]*datasourcev1.Value{
+						"plan":  {Kind: &datasourcev1.Value_StringValue{StringValue: "gold"}},
+						"seats": {Kind: &datasourcev1.Value_IntValue{IntValue: 25}},
+					},
+					ChangedAt: timestamppb.New(time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)),
+				},
+				{Uid: "beta", Activate: "false"},
+			},
+			Next: "beta",
+		}, nil
+	}
+	return &datasourcev1.QueryNodesResponse{
+		Rows: []*datasourcev1.Row{
+			{
+				Uid:      "gamma",
+				Activate: "1",
+				Extra: map[string]*datasourcev1.Value{
+					"seats": {Kind: &datasourcev1.Value_FloatValue{FloatValue: 2.5}},
+				},
+			},
+		},
+	}, nil
+}
+
+// startPluginServer serves srv on a loopback port and returns its address
+func startPluginServer(t *testing.T, srv datasourcev1.DatasourceServer) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	datasourcev1.RegisterDatasourceServer(server, srv)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+func TestPluginAdapter_QueryNodes(t *testing.T) {
+	plugin := &fakePlugin{}
+	addr := startPluginServer(t, plugin)
+
+	adapter, err := NewPluginAdapter(Config{
+		Password: "s3cret\n",
+		Plugin:   &PluginConfig{Endpoint: addr, Options: map[string]string{"collection": "tenants"}},
+	})
+	require.NoError(t, err)
+	defer func() {
+		_ = adapter.Close()
+	}()
+
+	since := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	rows, err := adapter.QueryNodes(context.Background(), QueryConfig{
+		ValueMappings:  ValueMappings{UID: "id", Activate: "enabled"},
+		ExtraMappings:  map[string]string{"plan": "plan", "seats": "seats"},
+		ExtraTypes:     map[string]ValueType{"seats": ValueTypeAuto},
+		Filters:        []FilterCondition{{Column: "region", Operator: FilterOperatorIn, Values: []string{"eu", "us"}}},
+		ChangeTracking: &ChangeTracking{Column: "updated_at", Since: since},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []NodeRow{
+		{
+			UID:        "acme",
+			Activate:   "true",
+			Extra:      map[string]string{"plan": "gold", "seats": "25"},
+			ExtraTypes: map[string]ValueType{"seats": ValueTypeInt},
+			ChangedAt:  time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			UID:        "gamma",
+			Activate:   "1",
+			Extra:      map[string]string{"plan": "", "seats": "2.5"},
+			ExtraTypes: map[string]ValueType{"seats": ValueTypeFloat},
+		},
+	}, rows)
+
+	require.Len(t, plugin.requests, 2)
+	first := plugin.requests[0]
+	assert.Equal(t, "id", first.GetValueMappings().GetUid())
+	assert.Equal(t, "enabled", first.GetValueMappings().GetActivate())
+	assert.Empty(t, first.GetValueMappings().GetActiveFrom())
+	require.Len(t, first.GetFilters(), 1)
+	assert.Equal(t, "region", first.GetFilters()[0].GetColumn())
+	assert.Equal(t, "in", first.GetFilters()[0].GetOperator())
+	assert.Equal(t, []string{"eu", "us"}, first.GetFilters()[0].GetValues())
+	assert.Equal(t, "updated_at", first.GetChangeTracking().GetColumn())
+	assert.Equal(t, since, first.GetChangeTracking().GetSince().AsTime())
+	assert.Equal(t, "beta", plugin.requests[1].GetAfter())
+	assert.Equal(t, []string{"Bearer s3cret", "Bearer s3cret"}, plugin.tokens)
+}
+
+func TestPluginAdapter_Errors(t *testing.T) {
+	addr := startPluginServer(t, &fakePlugin{})
+
+	adapter, err := NewPluginAdapter(Config{
+		Plugin: &PluginConfig{Endpoint: addr, Options: map[string]string{"collection": "unknown"}},
+	})
+	require.NoError(t, err)
+	defer func() {
+		_ = adapter.Close()
+	}()
+
+	_, err = adapter.QueryNodes(context.Background(), QueryConfig{ValueMappings: ValueMappings{UID: "id", Activate: "enabled"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown collection")
+
+	_, err = NewPluginAdapter(Config{Plugin: &PluginConfig{}})
+	assert.Error(t, err)
+}
+
+func TestDecodePluginResponse(t *testing.T) {
+	config := QueryConfig{
+		ValueMappings:   ValueMappings{UID: "id", Activate: "enabled"},
+		ExtraMappings:   map[string]string{"tags": "tags", "owner": "owner"},
+		ExtraTypes:      map[string]ValueType{"tags": ValueTypeAuto},
+		IncludeInactive: true,
+	}
+
+	rows, next, err := decodePluginResponse(&datasourcev1.QueryNodesResponse{}, config)
+	require.NoError(t, err)
+	assert.Empty(t, rows)
+	assert.Empty(t, next)
+
+	rows, _, err = decodePluginResponse(&datasourcev1.QueryNodesResponse{
+		Rows: []*datasourcev1.Row{{
+			Uid: "a",
+			Extra: map[string]*datasourcev1.Value{
+				"tags": {Kind: &datasourcev1.Value_JsonValue{JsonValue: `["x","y"]`}},
+			},
+		}},
+	}, config)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, map[string]string{"tags": `["x","y"]`, "owner": ""}, rows[0].Extra)
+	assert.Equal(t, map[string]ValueType{"tags": ValueTypeJSON}, rows[0].ExtraTypes)
+
+	_, _, err = decodePluginResponse(&datasourcev1.QueryNodesResponse{
+		Rows: []*datasourcev1.Row{{Uid: "a", ChangedAt: &timestamppb.Timestamp{Nanos: -1}}},
+	}, config)
+	assert.Error(t, err)
+}
+
+func TestPluginHost(t *testing.T) {
+	assert.Equal(t, "plugin.tools.svc", pluginHost("dns:///plugin.tools.svc:9000"))
+	assert.Equal(t, "localhost", pluginHost("localhost:9000"))
+	assert.Equal(t, "unix", pluginHost("unix"))
+}
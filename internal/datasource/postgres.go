@@ -0,0 +1,157 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq" // PostgreSQL driver
+)
+
+// PostgresAdapter implements the Datasource interface for PostgreSQL
+type PostgresAdapter struct {
+	db     *sql.DB
+	schema string
+}
+
+// NewPostgresAdapter creates a new PostgreSQL datasource adapter
+func NewPostgresAdapter(config Config) (*PostgresAdapter, error) {
+	db, err := sql.Open("postgres", buildPostgresDSN(config))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open PostgreSQL connection: %w", err)
+	}
+
+	// Set connection pool settings
+	applyPoolSettings(db, config)
+
+	// Test connection
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout(config))
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close() // Best effort close on error
+		return nil, fmt.Errorf("failed to ping PostgreSQL: %w", wrapAuthError(err))
+	}
+
+	return &PostgresAdapter{db: db, schema: config.Schema}, nil
+}
+
+// QueryNodes queries active nodes from the PostgreSQL database
+func (a *PostgresAdapter) QueryNodes(ctx context.Context, config QueryConfig) ([]NodeRow, error) {
+	nodes, _, err := a.queryNodes(ctx, config, nil)
+	return nodes, err
+}
+
+// QueryNodePage queries one keyset page of active nodes ordered by the UID column
+func (a *PostgresAdapter) QueryNodePage(ctx context.Context, config QueryConfig, after string) ([]NodeRow, string, error) {
+	if config.PageSize <= 0 {
+		return nil, "", fmt.Errorf("page size must be greater than zero")
+	}
+	return a.queryNodes(ctx, config, &pageRequest{after: after, size: config.PageSize})
+}
+
+// queryNodes reads node rows and attaches their child rows
+func (a *PostgresAdapter) queryNodes(ctx context.Context, config QueryConfig, page *pageRequest) ([]NodeRow, string, error) {
+	nodes, next, err := postgresDialect.queryNodeRows(ctx, a.db, config, qualifyPostgresTable(a.schema, config.Table), page)
+	if err != nil {
+		return nil, "", err
+	}
+	relationTable := func(table string) string {
+		return qualifyPostgresTable(a.schema, table)
+	}
+	if err := postgresDialect.attachRelations(ctx, a.db, config, nodes, relationTable); err != nil {
+		return nil, "", err
+	}
+	return nodes, next, nil
+}
+
+// WriteStatus writes node status to the table of config in the schema of the source
+func (a *PostgresAdapter) WriteStatus(ctx context.Context, config WriteConfig, statuses []NodeStatus) (int, error) {
+	return postgresDialect.writeStatus(ctx, a.db, qualifyPostgresTable(a.schema, config.Table), config, statuses)
+}
+
+// Close closes the database connection
+func (a *PostgresAdapter) Close() error {
+	if a.db != nil {
+		return a.db.Close()
+	}
+	return nil
+}
+
+// PoolStats returns the connection pool statistics
+func (a *PostgresAdapter) PoolStats() sql.DBStats {
+	if a.db == nil {
+		return sql.DBStats{}
+	}
+	return a.db.Stats()
+}
+
+// Helper functions
+
+// buildPostgresDSN builds a postgres:// connection URL.
+// url.URL takes care of escaping credentials and the database name.
+func buildPostgresDSN(config Config) string {
+	sslMode := config.SSLMode
+	if sslMode == "" {
+		sslMode = "require" // libpq default
+	}
+
+	// connect_timeout is in whole seconds
+	timeoutSeconds := int(connectTimeout(config).Round(time.Second).Seconds())
+	if timeoutSeconds < 1 {
+		timeoutSeconds = 1
+	}
+
+	query := url.Values{}
+	query.Set("sslmode", sslMode)
+	query.Set("connect_timeout", strconv.Itoa(timeoutSeconds))
+
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(config.Username, config.Password),
+		Host:     net.JoinHostPort(config.Host, strconv.Itoa(int(config.Port))),
+		Path:     "/" + config.Database,
+		RawQuery: query.Encode(),
+	}
+	return dsn.String()
+}
+
+// postgresDialect uses double-quoted identifiers and $n placeholders
+var postgresDialect = sqlDialect{
+	quoteIdentifier: quotePostgresIdentifier,
+	placeholder:     func(n int) string { return "$" + strconv.Itoa(n) },
+}
+
+// quotePostgresIdentifier quotes an identifier, doubling any embedded double quotes
+func quotePostgresIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// qualifyPostgresTable returns the quoted table name, prefixed with the quoted schema if set
+func qualifyPostgresTable(schema, table string) string {
+	if schema == "" {
+		return quotePostgresIdentifier(table)
+	}
+	return quotePostgresIdentifier(schema) + "." + quotePostgresIdentifier(table)
+}
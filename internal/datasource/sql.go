@@ -0,0 +1,694 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// sqlDialect captures the syntax differences between SQL databases
+type sqlDialect struct {
+	// quoteIdentifier quotes a column or table identifier
+	quoteIdentifier func(name string) string
+	// placeholder returns the bind parameter placeholder for the n-th (1-based) argument
+	placeholder func(n int) string
+}
+
+// joinColumns quotes and joins column names for a SELECT list
+func (d sqlDialect) joinColumns(columns []string) string {
+	quoted := make([]string, len(columns))
+	for i, col := range columns {
+		quoted[i] = d.quoteIdentifier(col)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// buildWhereClause builds a WHERE clause (including the leading " WHERE ") from the filter
+// conditions and change tracking watermark of config.
+// Column names are quoted and values are always returned as bound arguments, never inlined.
+func (d sqlDialect) buildWhereClause(config QueryConfig) (string, []interface{}, error) {
+	predicates := make([]string, 0, len(config.Filters)+1)
+	var args []interface{}
+
+	// bind appends a value to args and returns its placeholder
+	bind := func(value interface{}) string {
+		args = append(args, value)
+		return d.placeholder(len(args))
+	}
+
+	for _, f := range config.Filters {
+		if f.Column == "" {
+			return "", nil, fmt.Errorf("filter column is required")
+		}
+		col := d.quoteIdentifier(f.Column)
+
+		switch f.Operator {
+		case FilterOperatorIsNull, FilterOperatorIsNotNull:
+			if len(f.Values) != 0 {
+				return "", nil, fmt.Errorf("filter on %s: operator %s takes no values", f.Column, f.Operator)
+			}
+			if f.Operator == FilterOperatorIsNull {
+				predicates = append(predicates, col+" IS NULL")
+			} else {
+				predicates = append(predicates, col+" IS NOT NULL")
+			}
+
+		case FilterOperatorIn, FilterOperatorNotIn:
+			if len(f.Values) == 0 {
+				return "", nil, fmt.Errorf("filter on %s: operator %s requires at least one value", f.Column, f.Operator)
+			}
+			placeholders := make([]string, len(f.Values))
+			for i, v := range f.Values {
+				placeholders[i] = bind(v)
+			}
+			keyword := "IN"
+			if f.Operator == FilterOperatorNotIn {
+				keyword = "NOT IN"
+			}
+			predicates = append(predicates, fmt.Sprintf("%s %s (%s)", col, keyword, strings.Join(placeholders, ", ")))
+
+		default:
+			op, ok := comparisonOperators[f.Operator]
+			if !ok {
+				return "", nil, fmt.Errorf("filter on %s: unsupported operator %q", f.Column, f.Operator)
+			}
+			if len(f.Values) != 1 {
+				return "", nil, fmt.Errorf("filter on %s: operator %s requires exactly one value", f.Column, f.Operator)
+			}
+			predicates = append(predicates, fmt.Sprintf("%s %s %s", col, op, bind(f.Values[0])))
+		}
+	}
+
+	// Incremental query: rows changed at or after the watermark.
+	// >= re-reads rows sharing the watermark timestamp, which is harmless and
+	// avoids missing rows committed later with the same timestamp.
+	if ct := config.ChangeTracking; ct != nil && !ct.Since.IsZero() {
+		if ct.Column == "" {
+			return "", nil, fmt.Errorf("change tracking column is required")
+		}
+		predicates = append(predicates, fmt.Sprintf("%s >= %s", d.quoteIdentifier(ct.Column), bind(ct.Since)))
+	}
+
+	if len(predicates) == 0 {
+		return "", nil, nil
+	}
+
+	return " WHERE " + strings.Join(predicates, " AND "), args, nil
+}
+
+// pageRequest selects one keyset page of a node query
+type pageRequest struct {
+	// after is the UID to continue after ("" for the first page)
+	after string
+	// size is the maximum number of rows read
+	size int
+}
+
+// queryNodeRows runs a node query against db and scans the result.
+// With a page request the query reads one keyset page ordered by the UID column,
+// and the returned cursor is the UID to continue after ("" once the last page was read).
+func (d sqlDialect) queryNodeRows(ctx context.Context, db *sql.DB, config QueryConfig, table string, page *pageRequest) ([]NodeRow, string, error) {
+	cols := newSQLColumns(config)
+
+	// Build WHERE clause from filters (values are bound, never concatenated)
+	where, args, err := d.buildWhereClause(config)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid filter: %w", err)
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s%s",
+		d.joinColumns(cols.all),
+		d.fromSource(config, table),
+		where,
+	)
+	if page != nil {
+		query, args = d.paginate(query, where != "", args, config.ValueMappings.UID, *page)
+	}
+
+	// Execute query
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		// New pool connections authenticate again, e.g. after the password was rotated
+		return nil, "", fmt.Errorf("failed to query nodes: %w", wrapAuthError(err))
+	}
+	defer func() {
+		_ = rows.Close() // Best effort close
+	}()
+
+	nodes, cursor, err := scanNodeRows(rows, config, cols)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// A short page is the last page
+	if page == nil || cursor.scanned < page.size {
+		return nodes, "", nil
+	}
+	return nodes, cursor.lastUID, nil
+}
+
+// relationBatchSize is the number of parent UIDs bound per child row query
+const relationBatchSize = 500
+
+// attachRelations reads the child rows of every relation in config for nodes and stores
+// each list as a JSON extra value. table returns the FROM target of a child table.
+// Nodes without child rows get an empty list.
+func (d sqlDialect) attachRelations(ctx context.Context, db *sql.DB, config QueryConfig, nodes []NodeRow, table func(name string) string) error {
+	if len(config.Relations) == 0 || len(nodes) == 0 {
+		return nil
+	}
+
+	uids := make([]string, 0, len(nodes))
+	seen := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		if !seen[node.UID] {
+			seen[node.UID] = true
+			uids = append(uids, node.UID)
+		}
+	}
+
+	// Rows of one scan share their ExtraTypes map, so the relation types are added to a copy
+	types := make(map[string]ValueType, len(nodes[0].ExtraTypes)+len(config.Relations))
+	for key, valueType := range nodes[0].ExtraTypes {
+		types[key] = valueType
+	}
+
+	for key, relation := range config.Relations {
+		items, err := d.queryRelation(ctx, db, relation, table(relation.Table), uids)
+		if err != nil {
+			return fmt.Errorf("relation %s: %w", key, err)
+		}
+		for i := range nodes {
+			list := items[nodes[i].UID]
+			if list == nil {
+				list = []map[string]string{}
+			}
+			data, err := json.Marshal(list)
+			if err != nil {
+				return fmt.Errorf("relation %s: %w", key, err)
+			}
+			if nodes[i].Extra == nil {
+				nodes[i].Extra = make(map[string]string)
+			}
+			nodes[i].Extra[key] = string(data)
+		}
+		types[key] = ValueTypeJSON
+	}
+
+	for i := range nodes {
+		nodes[i].ExtraTypes = types
+	}
+	return nil
+}
+
+// queryRelation reads the child rows of uids from table, grouped by parent UID.
+// UIDs are bound in batches of relationBatchSize, never inlined.
+func (d sqlDialect) queryRelation(ctx context.Context, db *sql.DB, relation Relation, table string, uids []string) (map[string][]map[string]string, error) {
+	keys := make([]string, 0, len(relation.Columns))
+	for key := range relation.Columns {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	columns := []string{relation.ForeignKey}
+	orderBy := []string{relation.ForeignKey}
+	if relation.OrderBy != "" {
+		orderBy = append(orderBy, relation.OrderBy)
+	}
+	for _, key := range keys {
+		columns = append(columns, relation.Columns[key])
+		orderBy = append(orderBy, relation.Columns[key])
+	}
+
+	items := make(map[string][]map[string]string)
+	for start := 0; start < len(uids); start += relationBatchSize {
+		batch := uids[start:min(start+relationBatchSize, len(uids))]
+		placeholders := make([]string, len(batch))
+		args := make([]interface{}, len(batch))
+		for i, uid := range batch {
+			placeholders[i] = d.placeholder(i + 1)
+			args[i] = uid
+		}
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE %s IN (%s) ORDER BY %s",
+			d.joinColumns(columns),
+			table,
+			d.quoteIdentifier(relation.ForeignKey),
+			strings.Join(placeholders, ", "),
+			d.joinColumns(orderBy),
+		)
+
+		if err := d.scanRelationRows(ctx, db, query, args, keys, items); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// scanRelationRows runs a child row query and appends its rows to items
+func (d sqlDialect) scanRelationRows(ctx context.Context, db *sql.DB, query string, args []interface{}, keys []string, items map[string][]map[string]string) error {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to query child rows: %w", err)
+	}
+	defer func() {
+		_ = rows.Close() // Best effort close
+	}()
+
+	for rows.Next() {
+		values := make([]sql.NullString, len(keys)+1)
+		scanDest := make([]interface{}, len(values))
+		for i := range values {
+			scanDest[i] = &values[i]
+		}
+		if err := rows.Scan(scanDest...); err != nil {
+			return fmt.Errorf("failed to scan child row: %w", err)
+		}
+
+		item := make(map[string]string, len(keys))
+		for i, key := range keys {
+			item[key] = values[i+1].String // NULL becomes an empty string
+		}
+		parent := values[0].String
+		items[parent] = append(items[parent], item)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating child rows: %w", err)
+	}
+	return nil
+}
+
+// defaultStatusBatchSize is the number of statuses written per transaction when WriteConfig.BatchSize is unset
+const defaultStatusBatchSize = 100
+
+// writeStatus updates the status columns of the row of every status in table (the quoted UPDATE target),
+// one transaction per batch, and returns the number of statuses written by committed batches
+func (d sqlDialect) writeStatus(ctx context.Context, db *sql.DB, table string, config WriteConfig, statuses []NodeStatus) (int, error) {
+	var assignments []string
+	var values []func(NodeStatus) interface{}
+	set := func(column string, value func(NodeStatus) interface{}) {
+		if column == "" {
+			return
+		}
+		values = append(values, value)
+		assignments = append(assignments, fmt.Sprintf("%s = %s", d.quoteIdentifier(column), d.placeholder(len(values))))
+	}
+	set(config.Columns.Ready, func(s NodeStatus) interface{} { return s.Ready })
+	set(config.Columns.Phase, func(s NodeStatus) interface{} { return s.Phase })
+	set(config.Columns.Message, func(s NodeStatus) interface{} { return s.Message })
+	set(config.Columns.LastReconciled, func(s NodeStatus) interface{} { return s.LastReconciled.UTC() })
+	if len(assignments) == 0 {
+		return 0, fmt.Errorf("no status columns to write")
+	}
+	if config.UIDColumn == "" {
+		return 0, fmt.Errorf("uid column is required")
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE %s = %s",
+		table,
+		strings.Join(assignments, ", "),
+		d.quoteIdentifier(config.UIDColumn),
+		d.placeholder(len(values)+1),
+	)
+
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultStatusBatchSize
+	}
+	written := 0
+	for start := 0; start < len(statuses); start += batchSize {
+		batch := statuses[start:min(start+batchSize, len(statuses))]
+		if err := writeStatusBatch(ctx, db, query, values, batch); err != nil {
+			return written, err
+		}
+		written += len(batch)
+	}
+	return written, nil
+}
+
+// writeStatusBatch runs the status UPDATE for every status of batch in one transaction
+func writeStatusBatch(ctx context.Context, db *sql.DB, query string, values []func(NodeStatus) interface{}, batch []NodeStatus) (err error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin status transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback() // Best effort rollback
+		}
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to prepare status update: %w", err)
+	}
+	defer func() {
+		_ = stmt.Close() // Best effort close
+	}()
+
+	for _, status := range batch {
+		args := make([]interface{}, 0, len(values)+1)
+		for _, value := range values {
+			args = append(args, value(status))
+		}
+		args = append(args, status.UID)
+		if _, err = stmt.ExecContext(ctx, args...); err != nil {
+			return fmt.Errorf("failed to write status of %s: %w", status.UID, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit status transaction: %w", err)
+	}
+	return nil
+}
+
+// paginate restricts a node query to one keyset page: rows with a UID greater than
+// the cursor, ordered by UID. NULL UIDs cannot be ordered reliably and are skipped.
+func (d sqlDialect) paginate(query string, hasWhere bool, args []interface{}, uidColumn string, page pageRequest) (string, []interface{}) {
+	uid := d.quoteIdentifier(uidColumn)
+	predicate := uid + " IS NOT NULL"
+	if page.after != "" {
+		args = append(args, page.after)
+		predicate = fmt.Sprintf("%s > %s", uid, d.placeholder(len(args)))
+	}
+
+	if hasWhere {
+		query += " AND " + predicate
+	} else {
+		query += " WHERE " + predicate
+	}
+	return fmt.Sprintf("%s ORDER BY %s LIMIT %d", query, uid, page.size), args
+}
+
+// querySourceAlias is the alias given to a custom query wrapped as a derived table
+const querySourceAlias = "lynq_source"
+
+// fromSource returns the FROM target for a query configuration.
+// A custom query is wrapped as a derived table so that column selection and
+// filters apply to its result exactly as they would to a table.
+func (d sqlDialect) fromSource(config QueryConfig, table string) string {
+	if config.Query == "" {
+		return table
+	}
+	query := strings.TrimRight(strings.TrimSpace(config.Query), "; \t\r\n")
+	return "(" + query + ") AS " + d.quoteIdentifier(querySourceAlias)
+}
+
+// comparisonOperators maps single-value filter operators to SQL
+var comparisonOperators = map[FilterOperator]string{
+	FilterOperatorEquals:             "=",
+	FilterOperatorNotEquals:          "<>",
+	FilterOperatorGreaterThan:        ">",
+	FilterOperatorGreaterThanOrEqual: ">=",
+	FilterOperatorLessThan:           "<",
+	FilterOperatorLessThanOrEqual:    "<=",
+	FilterOperatorLike:               "LIKE",
+}
+
+// sqlColumns describes the column layout of a node query.
+// It is shared by all database/sql based adapters so that every adapter
+// selects and scans columns in exactly the same order.
+type sqlColumns struct {
+	// all lists every selected column in query order
+	all []string
+	// extra lists the extra mapping columns in query order
+	extra []string
+	// includeHostOrURL is true when the deprecated hostOrUrl column is selected
+	includeHostOrURL bool
+	// includeActiveFrom and includeActiveUntil are true when the activation window columns are selected
+	includeActiveFrom  bool
+	includeActiveUntil bool
+	// includeChangedAt is true when the change tracking column is selected (always last)
+	includeChangedAt bool
+}
+
+// newSQLColumns builds the column layout for the given query configuration
+func newSQLColumns(config QueryConfig) sqlColumns {
+	// Build column list - start with required fields
+	cols := sqlColumns{
+		all: []string{config.ValueMappings.UID},
+	}
+
+	// Add HostOrURL only if specified (deprecated, optional since v1.1.11)
+	cols.includeHostOrURL = config.ValueMappings.HostOrURL != ""
+	if cols.includeHostOrURL {
+		cols.all = append(cols.all, config.ValueMappings.HostOrURL)
+	}
+
+	// Add activate column
+	cols.all = append(cols.all, config.ValueMappings.Activate)
+
+	// Add extra columns in sorted order for stable queries
+	// Sort the keys to ensure consistent column order
+	extraKeys := make([]string, 0, len(config.ExtraMappings))
+	for key := range config.ExtraMappings {
+		extraKeys = append(extraKeys, key)
+	}
+	sort.Strings(extraKeys)
+
+	cols.extra = make([]string, 0, len(config.ExtraMappings))
+	for _, key := range extraKeys {
+		col := config.ExtraMappings[key]
+		cols.all = append(cols.all, col)
+		cols.extra = append(cols.extra, col)
+	}
+
+	// Add activation window columns after the extra columns
+	cols.includeActiveFrom = config.ValueMappings.ActiveFrom != ""
+	if cols.includeActiveFrom {
+		cols.all = append(cols.all, config.ValueMappings.ActiveFrom)
+	}
+	cols.includeActiveUntil = config.ValueMappings.ActiveUntil != ""
+	if cols.includeActiveUntil {
+		cols.all = append(cols.all, config.ValueMappings.ActiveUntil)
+	}
+
+	// Add change tracking column last so it never shifts the extra columns
+	if config.ChangeTracking != nil && config.ChangeTracking.Column != "" {
+		cols.includeChangedAt = true
+		cols.all = append(cols.all, config.ChangeTracking.Column)
+	}
+
+	return cols
+}
+
+// scanCursor records how far a scan got, for keyset pagination
+type scanCursor struct {
+	// scanned is the number of rows read, including inactive rows
+	scanned int
+	// lastUID is the UID of the last row read
+	lastUID string
+}
+
+// scanNodeRows scans query results laid out by cols into active node rows
+func scanNodeRows(rows *sql.Rows, config QueryConfig, cols sqlColumns) ([]NodeRow, scanCursor, error) {
+	// Build column index map once for stable extra value mapping
+	colIndex := make(map[string]int, len(cols.extra))
+	for i, col := range cols.extra {
+		colIndex[col] = i
+	}
+
+	// Resolve typed extra mappings once per query
+	extraTypes := resolveExtraTypes(rows, config, cols, colIndex)
+
+	var nodes []NodeRow
+	var cursor scanCursor
+	for rows.Next() {
+		row := NodeRow{
+			Extra:      make(map[string]string),
+			ExtraTypes: extraTypes,
+		}
+
+		// Use NullString for required fields to handle NULL values
+		var uid, hostOrURL, activate sql.NullString
+
+		// Prepare scan destinations based on which columns were queried
+		scanDest := []interface{}{&uid}
+		if cols.includeHostOrURL {
+			scanDest = append(scanDest, &hostOrURL)
+		}
+		scanDest = append(scanDest, &activate)
+
+		// Add extra column destinations
+		extraValues := make([]sql.NullString, len(cols.extra))
+		for i := range extraValues {
+			scanDest = append(scanDest, &extraValues[i])
+		}
+
+		var activeFrom, activeUntil sql.NullString
+		if cols.includeActiveFrom {
+			scanDest = append(scanDest, &activeFrom)
+		}
+		if cols.includeActiveUntil {
+			scanDest = append(scanDest, &activeUntil)
+		}
+
+		var changedAt sql.NullTime
+		if cols.includeChangedAt {
+			scanDest = append(scanDest, &changedAt)
+		}
+
+		if err := rows.Scan(scanDest...); err != nil {
+			return nil, cursor, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		// Convert NullString to string (NULL becomes empty string)
+		if uid.Valid {
+			row.UID = uid.String
+		}
+		cursor.scanned++
+		cursor.lastUID = row.UID
+		if hostOrURL.Valid {
+			row.HostOrURL = hostOrURL.String
+		}
+		if activate.Valid {
+			row.Activate = activate.String
+		}
+		if activeFrom.Valid {
+			row.ActiveFrom = activeFrom.String
+		}
+		if activeUntil.Valid {
+			row.ActiveUntil = activeUntil.String
+		}
+		if changedAt.Valid {
+			row.ChangedAt = changedAt.Time
+		}
+
+		// Map extra values using stable indices
+		for key, col := range config.ExtraMappings {
+			idx, ok := colIndex[col]
+			if !ok {
+				// Column not in result set (shouldn't happen)
+				row.Extra[key] = ""
+				continue
+			}
+			if extraValues[idx].Valid {
+				row.Extra[key] = extraValues[idx].String
+			} else {
+				row.Extra[key] = "" // Null values become empty strings
+			}
+		}
+
+		// Filter: only include active nodes (unless the caller asked for all rows)
+		// Note: HostOrURL is deprecated since v1.1.11 and no longer required
+		if config.IncludeInactive || config.ValueMappings.Activation.IsActive(row.Activate) {
+			nodes = append(nodes, row)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, cursor, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return nodes, cursor, nil
+}
+
+// resolveExtraTypes returns the type of every typed extra mapping.
+// ValueTypeAuto is resolved from the database type of the column; string mappings are omitted.
+func resolveExtraTypes(rows *sql.Rows, config QueryConfig, cols sqlColumns, colIndex map[string]int) map[string]ValueType {
+	if len(config.ExtraTypes) == 0 {
+		return nil
+	}
+
+	// Extra columns follow uid, the optional hostOrUrl and activate columns
+	offset := 2
+	if cols.includeHostOrURL {
+		offset = 3
+	}
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		columnTypes = nil // Fall back to strings for auto mappings
+	}
+
+	types := make(map[string]ValueType)
+	for key, valueType := range config.ExtraTypes {
+		if valueType == ValueTypeAuto {
+			valueType = ValueTypeString
+			if idx, ok := colIndex[config.ExtraMappings[key]]; ok && offset+idx < len(columnTypes) {
+				valueType = valueTypeForDatabaseType(columnTypes[offset+idx].DatabaseTypeName())
+			}
+		}
+		if valueType != ValueTypeString && valueType != "" {
+			types[key] = valueType
+		}
+	}
+	if len(types) == 0 {
+		return nil
+	}
+	return types
+}
+
+// valueTypeForDatabaseType maps a driver database type name to a value type.
+// MySQL BOOLEAN is TINYINT(1) and therefore resolves to int; use an explicit bool type for it.
+func valueTypeForDatabaseType(name string) ValueType {
+	name = strings.TrimPrefix(strings.ToUpper(name), "UNSIGNED ")
+	switch name {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "INTEGER", "BIGINT", "INT2", "INT4", "INT8", "YEAR":
+		return ValueTypeInt
+	case "FLOAT", "DOUBLE", "REAL", "DECIMAL", "NUMERIC", "FLOAT4", "FLOAT8":
+		return ValueTypeFloat
+	case "BOOL", "BOOLEAN":
+		return ValueTypeBool
+	case "JSON", "JSONB":
+		return ValueTypeJSON
+	default:
+		return ValueTypeString
+	}
+}
+
+// applyPoolSettings applies connection pool settings from config, falling back to defaults
+func applyPoolSettings(db *sql.DB, config Config) {
+	maxOpenConns := config.MaxOpenConns
+	if maxOpenConns == 0 {
+		maxOpenConns = 25 // Default
+	}
+	db.SetMaxOpenConns(maxOpenConns)
+
+	maxIdleConns := config.MaxIdleConns
+	if maxIdleConns == 0 {
+		maxIdleConns = 5 // Default
+	}
+	db.SetMaxIdleConns(maxIdleConns)
+
+	db.SetConnMaxLifetime(parseDurationOrDefault(config.ConnMaxLifetime, 5*time.Minute))
+}
+
+// defaultConnectTimeout is used when Config.ConnectTimeout is not set
+const defaultConnectTimeout = 5 * time.Second
+
+// connectTimeout returns the configured connect timeout, falling back to the default
+func connectTimeout(config Config) time.Duration {
+	return parseDurationOrDefault(config.ConnectTimeout, defaultConnectTimeout)
+}
+
+// parseDurationOrDefault parses a duration string, returning def if it is empty or invalid
+func parseDurationOrDefault(value string, def time.Duration) time.Duration {
+	if value == "" {
+		return def
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed <= 0 {
+		return def
+	}
+	return parsed
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildWhereClause(t *testing.T) {
+	tests := []struct {
+		name          string
+		dialect       sqlDialect
+		filters       []FilterCondition
+		tracking      *ChangeTracking
+		wantWhere     string
+		wantArgs      []interface{}
+		wantErr       bool
+		errorContains string
+	}{
+		{
+			name:      "no filters",
+			dialect:   mysqlDialect,
+			filters:   nil,
+			wantWhere: "",
+			wantArgs:  nil,
+		},
+		{
+			name:    "mysql single equality",
+			dialect: mysqlDialect,
+			filters: []FilterCondition{
+				{Column: "region", Operator: FilterOperatorEquals, Values: []string{"us-east-1"}},
+			},
+			wantWhere: " WHERE `region` = ?",
+			wantArgs:  []interface{}{"us-east-1"},
+		},
+		{
+			name:    "mysql conditions are combined with AND",
+			dialect: mysqlDialect,
+			filters: []FilterCondition{
+				{Column: "cluster", Operator: FilterOperatorIn, Values: []string{"prod-a", "prod-b"}},
+				{Column: "deleted_at", Operator: FilterOperatorIsNull},
+				{Column: "tier", Operator: FilterOperatorNotEquals, Values: []string{"free"}},
+			},
+			wantWhere: " WHERE `cluster` IN (?, ?) AND `deleted_at` IS NULL AND `tier` <> ?",
+			wantArgs:  []interface{}{"prod-a", "prod-b", "free"},
+		},
+		{
+			name:    "postgres numbered placeholders",
+			dialect: postgresDialect,
+			filters: []FilterCondition{
+				{Column: "region", Operator: FilterOperatorNotIn, Values: []string{"eu", "ap"}},
+				{Column: "seats", Operator: FilterOperatorGreaterThanOrEqual, Values: []string{"10"}},
+				{Column: "name", Operator: FilterOperatorLike, Values: []string{"acme%"}},
+			},
+			wantWhere: ` WHERE "region" NOT IN ($1, $2) AND "seats" >= $3 AND "name" LIKE $4`,
+			wantArgs:  []interface{}{"eu", "ap", "10", "acme%"},
+		},
+		{
+			name:    "values are never inlined",
+			dialect: mysqlDialect,
+			filters: []FilterCondition{
+				{Column: "region", Operator: FilterOperatorEquals, Values: []string{"x' OR '1'='1"}},
+			},
+			wantWhere: " WHERE `region` = ?",
+			wantArgs:  []interface{}{"x' OR '1'='1"},
+		},
+		{
+			name:    "column names are escaped",
+			dialect: mysqlDialect,
+			filters: []FilterCondition{
+				{Column: "weird`col", Operator: FilterOperatorIsNotNull},
+			},
+			wantWhere: " WHERE `weird``col` IS NOT NULL",
+		},
+		{
+			name:      "change tracking without watermark reads all rows",
+			dialect:   mysqlDialect,
+			tracking:  &ChangeTracking{Column: "updated_at"},
+			wantWhere: "",
+		},
+		{
+			name:    "change tracking watermark follows filters",
+			dialect: postgresDialect,
+			filters: []FilterCondition{
+				{Column: "region", Operator: FilterOperatorEquals, Values: []string{"eu"}},
+			},
+			tracking:  &ChangeTracking{Column: "updated_at", Since: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+			wantWhere: ` WHERE "region" = $1 AND "updated_at" >= $2`,
+			wantArgs:  []interface{}{"eu", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:    "unsupported operator",
+			dialect: mysqlDialect,
+			filters: []FilterCondition{
+				{Column: "region", Operator: FilterOperator("regex"), Values: []string{".*"}},
+			},
+			wantErr:       true,
+			errorContains: "unsupported operator",
+		},
+		{
+			name:    "in without values",
+			dialect: mysqlDialect,
+			filters: []FilterCondition{
+				{Column: "region", Operator: FilterOperatorIn},
+			},
+			wantErr:       true,
+			errorContains: "at least one value",
+		},
+		{
+			name:    "equality with multiple values",
+			dialect: mysqlDialect,
+			filters: []FilterCondition{
+				{Column: "region", Operator: FilterOperatorEquals, Values: []string{"a", "b"}},
+			},
+			wantErr:       true,
+			errorContains: "exactly one value",
+		},
+		{
+			name:    "isNull with a value",
+			dialect: mysqlDialect,
+			filters: []FilterCondition{
+				{Column: "region", Operator: FilterOperatorIsNull, Values: []string{"a"}},
+			},
+			wantErr:       true,
+			errorContains: "takes no values",
+		},
+		{
+			name:    "missing column",
+			dialect: mysqlDialect,
+			filters: []FilterCondition{
+				{Operator: FilterOperatorIsNull},
+			},
+			wantErr:       true,
+			errorContains: "column is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			where, args, err := tt.dialect.buildWhereClause(QueryConfig{Filters: tt.filters, ChangeTracking: tt.tracking})
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantWhere, where)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestParseDurationOrDefault(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "empty uses default", value: "", want: time.Minute},
+		{name: "valid duration", value: "30s", want: 30 * time.Second},
+		{name: "invalid uses default", value: "soon", want: time.Minute},
+		{name: "non-positive uses default", value: "0s", want: time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseDurationOrDefault(tt.value, time.Minute))
+		})
+	}
+}
+
+func TestValueTypeForDatabaseType(t *testing.T) {
+	tests := []struct {
+		name string
+		want ValueType
+	}{
+		{name: "INT", want: ValueTypeInt},
+		{name: "UNSIGNED BIGINT", want: ValueTypeInt},
+		{name: "int8", want: ValueTypeInt},
+		{name: "DECIMAL", want: ValueTypeFloat},
+		{name: "FLOAT8", want: ValueTypeFloat},
+		{name: "BOOL", want: ValueTypeBool},
+		{name: "JSONB", want: ValueTypeJSON},
+		{name: "VARCHAR", want: ValueTypeString},
+		{name: "TIMESTAMP", want: ValueTypeString},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, valueTypeForDatabaseType(tt.name))
+		})
+	}
+}
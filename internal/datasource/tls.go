@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// TLSError reports that a TLS handshake with the database failed,
+// e.g. because of an untrusted CA, a host name mismatch or a rejected client certificate.
+type TLSError struct {
+	Err error
+}
+
+func (e *TLSError) Error() string {
+	return "TLS handshake failed: " + e.Err.Error()
+}
+
+func (e *TLSError) Unwrap() error {
+	return e.Err
+}
+
+// buildTLSConfig converts a TLSConfig into a crypto/tls configuration.
+// host is used for server name verification unless ServerName is set.
+func buildTLSConfig(config *TLSConfig, host string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         config.ServerName,
+		InsecureSkipVerify: config.InsecureSkipVerify, // Explicit opt-in for testing only
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = host
+	}
+
+	if len(config.CA) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(config.CA) {
+			return nil, fmt.Errorf("CA bundle contains no valid PEM certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	hasCert, hasKey := len(config.ClientCert) > 0, len(config.ClientKey) > 0
+	if hasCert != hasKey {
+		return nil, fmt.Errorf("client certificate and client key must be set together")
+	}
+	if hasCert {
+		cert, err := tls.X509KeyPair(config.ClientCert, config.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// isTLSError reports whether err was caused by the TLS handshake
+func isTLSError(err error) bool {
+	var (
+		recordErr    tls.RecordHeaderError
+		alertErr     tls.AlertError
+		verifyErr    *tls.CertificateVerificationError
+		authorityErr x509.UnknownAuthorityError
+		hostnameErr  x509.HostnameError
+		invalidErr   x509.CertificateInvalidError
+		opErr        *net.OpError
+	)
+	switch {
+	case errors.As(err, &recordErr), errors.As(err, &alertErr), errors.As(err, &verifyErr),
+		errors.As(err, &authorityErr), errors.As(err, &hostnameErr), errors.As(err, &invalidErr):
+		return true
+	case errors.As(err, &opErr) && opErr.Op == "remote error":
+		// Alerts sent by the server (e.g. bad certificate) surface as net.OpError
+		return true
+	}
+	// Some drivers flatten handshake errors into plain strings
+	msg := err.Error()
+	return strings.Contains(msg, "tls: ") || strings.Contains(msg, "x509: ")
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// generateTestCertificate returns a self-signed PEM certificate and key
+func generateTestCertificate(t *testing.T, commonName string) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM
+}
+
+func TestBuildTLSConfig(t *testing.T) {
+	caPEM, _ := generateTestCertificate(t, "test-ca")
+	certPEM, keyPEM := generateTestCertificate(t, "lynq-client")
+	_, otherKeyPEM := generateTestCertificate(t, "other")
+
+	tests := []struct {
+		name          string
+		config        *TLSConfig
+		host          string
+		wantErr       bool
+		errorContains string
+		check         func(t *testing.T, config *TLSConfig, host string)
+	}{
+		{
+			name:   "server name defaults to host",
+			config: &TLSConfig{CA: caPEM},
+			host:   "db.example.com",
+			check: func(t *testing.T, config *TLSConfig, host string) {
+				tlsConfig, err := buildTLSConfig(config, host)
+				require.NoError(t, err)
+				assert.Equal(t, "db.example.com", tlsConfig.ServerName)
+				assert.NotNil(t, tlsConfig.RootCAs)
+				assert.Empty(t, tlsConfig.Certificates)
+			},
+		},
+		{
+			name:   "explicit server name and mTLS",
+			config: &TLSConfig{CA: caPEM, ClientCert: certPEM, ClientKey: keyPEM, ServerName: "mysql.internal"},
+			host:   "10.0.0.5",
+			check: func(t *testing.T, config *TLSConfig, host string) {
+				tlsConfig, err := buildTLSConfig(config, host)
+				require.NoError(t, err)
+				assert.Equal(t, "mysql.internal", tlsConfig.ServerName)
+				assert.Len(t, tlsConfig.Certificates, 1)
+			},
+		},
+		{
+			name:   "system roots when no CA is given",
+			config: &TLSConfig{InsecureSkipVerify: true},
+			host:   "db.example.com",
+			check: func(t *testing.T, config *TLSConfig, host string) {
+				tlsConfig, err := buildTLSConfig(config, host)
+				require.NoError(t, err)
+				assert.Nil(t, tlsConfig.RootCAs)
+				assert.True(t, tlsConfig.InsecureSkipVerify)
+			},
+		},
+		{
+			name:          "invalid CA bundle",
+			config:        &TLSConfig{CA: []byte("not a certificate")},
+			wantErr:       true,
+			errorContains: "no valid PEM certificates",
+		},
+		{
+			name:          "client certificate without key",
+			config:        &TLSConfig{ClientCert: certPEM},
+			wantErr:       true,
+			errorContains: "must be set together",
+		},
+		{
+			name:          "client key does not match certificate",
+			config:        &TLSConfig{ClientCert: certPEM, ClientKey: otherKeyPEM},
+			wantErr:       true,
+			errorContains: "invalid client certificate or key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr {
+				_, err := buildTLSConfig(tt.config, tt.host)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+				return
+			}
+			tt.check(t, tt.config, tt.host)
+		})
+	}
+}
+
+func TestRegisterMySQLTLSConfig(t *testing.T) {
+	caPEM, _ := generateTestCertificate(t, "test-ca")
+	otherCAPEM, _ := generateTestCertificate(t, "other-ca")
+
+	first, err := registerMySQLTLSConfig(&TLSConfig{CA: caPEM}, "db.example.com")
+	require.NoError(t, err)
+	again, err := registerMySQLTLSConfig(&TLSConfig{CA: caPEM}, "db.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, first, again, "identical TLS material shares one registration")
+
+	rotated, err := registerMySQLTLSConfig(&TLSConfig{CA: otherCAPEM}, "db.example.com")
+	require.NoError(t, err)
+	assert.NotEqual(t, first, rotated)
+
+	_, err = registerMySQLTLSConfig(&TLSConfig{CA: []byte("garbage")}, "db.example.com")
+	assert.Error(t, err)
+}
+
+func TestIsTLSError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "unknown authority", err: fmt.Errorf("ping: %w", x509.UnknownAuthorityError{}), want: true},
+		{name: "host name mismatch", err: x509.HostnameError{Certificate: &x509.Certificate{}, Host: "db"}, want: true},
+		{name: "flattened tls message", err: errors.New("remote error: tls: bad certificate"), want: true},
+		{name: "connection refused", err: errors.New("dial tcp 127.0.0.1:3306: connect: connection refused"), want: false},
+		{name: "access denied", err: errors.New("Error 1045: Access denied for user"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isTLSError(tt.err))
+		})
+	}
+}
+
+func TestTLSError(t *testing.T) {
+	inner := x509.UnknownAuthorityError{}
+	err := fmt.Errorf("failed to ping MySQL: %w", &TLSError{Err: inner})
+
+	var tlsErr *TLSError
+	require.True(t, errors.As(err, &tlsErr))
+	assert.Contains(t, err.Error(), "TLS handshake failed")
+	assert.True(t, errors.As(err, new(x509.UnknownAuthorityError)))
+}
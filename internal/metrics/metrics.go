@@ -86,6 +86,54 @@ var (
 		[]string{"registry", "namespace"},
 	)
 
+	// RegistryRowsInvalid tracks the uids skipped by row validation per registry
+	RegistryRowsInvalid = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "registry_rows_invalid",
+			Help: "Number of uids skipped by row validation (invalid uid or activation window) for a registry",
+		},
+		[]string{"registry", "namespace"},
+	)
+
+	// RegistryDatasourceConnections tracks the datasource connection pool per registry
+	// state: open, in_use, idle
+	RegistryDatasourceConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "registry_datasource_connections",
+			Help: "Number of datasource connections in the registry's connection pool by state (open, in_use, idle)",
+		},
+		[]string{"registry", "namespace", "state"},
+	)
+
+	// RegistryDatasourceMaxOpenConnections tracks the connection pool size limit per registry
+	RegistryDatasourceMaxOpenConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "registry_datasource_max_open_connections",
+			Help: "Maximum number of open datasource connections for a registry",
+		},
+		[]string{"registry", "namespace"},
+	)
+
+	// RegistryDatasourceWaitCount tracks how often a query waited for a free connection
+	// Cumulative for the lifetime of the current pool
+	RegistryDatasourceWaitCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "registry_datasource_wait_count",
+			Help: "Number of times a registry query waited for a datasource connection (current pool)",
+		},
+		[]string{"registry", "namespace"},
+	)
+
+	// RegistryDatasourceWaitDuration tracks the total time spent waiting for a free connection
+	// Cumulative for the lifetime of the current pool
+	RegistryDatasourceWaitDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "registry_datasource_wait_duration_seconds",
+			Help: "Total time a registry waited for datasource connections in seconds (current pool)",
+		},
+		[]string{"registry", "namespace"},
+	)
+
 	// ApplyAttemptsTotal counts resource apply attempts
 	ApplyAttemptsTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -143,6 +191,11 @@ func init() {
 		RegistryDesired,
 		RegistryReady,
 		RegistryFailed,
+		RegistryRowsInvalid,
+		RegistryDatasourceConnections,
+		RegistryDatasourceMaxOpenConnections,
+		RegistryDatasourceWaitCount,
+		RegistryDatasourceWaitDuration,
 		ApplyAttemptsTotal,
 		LynqNodeConditionStatus,
 		LynqNodeConflictsTotal,
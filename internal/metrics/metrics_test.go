@@ -170,6 +170,32 @@ registry_failed{namespace="default",registry="mysql-prod"} 5
 	assert.NoError(t, err)
 }
 
+func TestRegistryDatasourceConnections(t *testing.T) {
+	RegistryDatasourceConnections.Reset()
+
+	// Set pool stats by state
+	RegistryDatasourceConnections.WithLabelValues("mysql-prod", "default", "open").Set(4)
+	RegistryDatasourceConnections.WithLabelValues("mysql-prod", "default", "in_use").Set(1)
+	RegistryDatasourceConnections.WithLabelValues("mysql-prod", "default", "idle").Set(3)
+
+	count := testutil.CollectAndCount(RegistryDatasourceConnections)
+	assert.Equal(t, 3, count)
+
+	expected := `
+# HELP registry_datasource_connections Number of datasource connections in the registry's connection pool by state (open, in_use, idle)
+# TYPE registry_datasource_connections gauge
+registry_datasource_connections{namespace="default",registry="mysql-prod",state="idle"} 3
+registry_datasource_connections{namespace="default",registry="mysql-prod",state="in_use"} 1
+registry_datasource_connections{namespace="default",registry="mysql-prod",state="open"} 4
+`
+	err := testutil.CollectAndCompare(RegistryDatasourceConnections, strings.NewReader(expected))
+	assert.NoError(t, err)
+
+	// Deleting a hub's series removes it from the output
+	RegistryDatasourceConnections.DeleteLabelValues("mysql-prod", "default", "idle")
+	assert.Equal(t, 2, testutil.CollectAndCount(RegistryDatasourceConnections))
+}
+
 func TestApplyAttemptsTotal(t *testing.T) {
 	ApplyAttemptsTotal.Reset()
 
@@ -285,6 +311,10 @@ func TestMetricsRegistration(t *testing.T) {
 		RegistryDesired,
 		RegistryReady,
 		RegistryFailed,
+		RegistryDatasourceConnections,
+		RegistryDatasourceMaxOpenConnections,
+		RegistryDatasourceWaitCount,
+		RegistryDatasourceWaitDuration,
 		ApplyAttemptsTotal,
 		LynqNodeConditionStatus,
 		LynqNodeConflictsTotal,
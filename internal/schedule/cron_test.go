@@ -0,0 +1,90 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	// Saturday
+	from := time.Date(2025, 3, 1, 10, 17, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{spec: "* * * * *", want: time.Date(2025, 3, 1, 10, 18, 0, 0, time.UTC)},
+		{spec: "*/15 * * * *", want: time.Date(2025, 3, 1, 10, 30, 0, 0, time.UTC)},
+		{spec: "0 2 * * *", want: time.Date(2025, 3, 2, 2, 0, 0, 0, time.UTC)},
+		{spec: "30 1-5/2 * * *", want: time.Date(2025, 3, 2, 1, 30, 0, 0, time.UTC)},
+		{spec: "0 2 * * mon-fri", want: time.Date(2025, 3, 3, 2, 0, 0, 0, time.UTC)},
+		{spec: "0 0 * * 7", want: time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 15 * *", want: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either may match
+		{spec: "0 0 15 * 1", want: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 29 2 *", want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 12 1 JAN,jul *", want: time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)},
+		{spec: "@hourly", want: time.Date(2025, 3, 1, 11, 0, 0, 0, time.UTC)},
+		{spec: "@weekly", want: time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{spec: "CRON_TZ=Europe/Berlin 0 2 * * *", want: time.Date(2025, 3, 2, 1, 0, 0, 0, time.UTC)},
+		// Never activates
+		{spec: "0 0 30 2 *", want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := Parse(tt.spec)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(s.Next(from)), "got %s", s.Next(from))
+		})
+	}
+}
+
+func TestSchedule_NextIsStrictlyAfter(t *testing.T) {
+	s, err := Parse("0 2 * * *")
+	require.NoError(t, err)
+	at := time.Date(2025, 3, 1, 2, 0, 0, 0, time.UTC)
+	assert.Equal(t, at.AddDate(0, 0, 1), s.Next(at))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@every 5m",
+		"CRON_TZ=Mars/Olympus 0 2 * * *",
+	} {
+		t.Run(spec, func(t *testing.T) {
+			_, err := Parse(spec)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -141,8 +142,9 @@ func fromJson(jsonStr string) interface{} {
 }
 
 // BuildVariables creates Variables from database row data
+// extraTypes gives the type (int, float, bool, json) of typed extra values; other values stay strings.
 // Note: hostOrURL and host are deprecated since v1.1.11 and will be removed in v1.3.0
-func BuildVariables(uid, hostOrURL, activate string, extraMappings map[string]string) Variables {
+func BuildVariables(uid, hostOrURL, activate string, extraMappings map[string]string, extraTypes map[string]string) Variables {
 	vars := Variables{
 		"uid":      uid,
 		"activate": activate,
@@ -158,8 +160,75 @@ func BuildVariables(uid, hostOrURL, activate string, extraMappings map[string]st
 
 	// Add extra mappings
 	for k, v := range extraMappings {
-		vars[k] = v
+		vars[k] = TypedValue(v, extraTypes[k])
 	}
 
 	return vars
 }
+
+// TypedValue converts a string value to the native Go type for valueType:
+// int -> int64, float -> float64, bool -> bool, json -> decoded document.
+// An empty value of a typed mapping is a database NULL and becomes nil.
+// Values that cannot be parsed are kept as strings so rendering never fails on bad data.
+func TypedValue(value, valueType string) interface{} {
+	switch valueType {
+	case "int", "float", "bool", "json":
+		if value == "" {
+			return nil
+		}
+	default:
+		return value
+	}
+
+	switch valueType {
+	case "int":
+		if i, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err == nil {
+			return i
+		}
+	case "float":
+		if f, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+			return f
+		}
+	case "bool":
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "1", "true", "t", "yes", "y", "on":
+			return true
+		case "0", "false", "f", "no", "n", "off":
+			return false
+		}
+	case "json":
+		decoder := json.NewDecoder(strings.NewReader(value))
+		decoder.UseNumber()
+		var doc interface{}
+		if err := decoder.Decode(&doc); err == nil {
+			return normalizeJSONNumbers(doc)
+		}
+	}
+	return value
+}
+
+// normalizeJSONNumbers converts json.Number values into int64 or float64
+func normalizeJSONNumbers(v interface{}) interface{} {
+	switch val := v.(type) {
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		if f, err := val.Float64(); err == nil {
+			return f
+		}
+		return val.String()
+	case map[string]interface{}:
+		for k, item := range val {
+			val[k] = normalizeJSONNumbers(item)
+		}
+		return val
+	case []interface{}:
+		for i, item := range val {
+			val[i] = normalizeJSONNumbers(item)
+		}
+		return val
+	default:
+		return v
+	}
+}
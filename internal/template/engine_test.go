@@ -481,7 +481,7 @@ func TestBuildVariables(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := BuildVariables(tt.uid, tt.hostOrURL, tt.activate, tt.extraMappings)
+			result := BuildVariables(tt.uid, tt.hostOrURL, tt.activate, tt.extraMappings, nil)
 
 			// Check all expected keys exist
 			for _, key := range tt.wantKeys {
@@ -504,3 +504,59 @@ func TestBuildVariables(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildVariables_Typed(t *testing.T) {
+	vars := BuildVariables("node-1", "", "true", map[string]string{
+		"replicas": "3",
+		"ratio":    "0.5",
+		"enabled":  "1",
+		"config":   `{"tier":"gold","limits":{"cpu":2,"memory":1.5},"zones":["a","b"]}`,
+		"missing":  "",
+		"broken":   "three",
+		"name":     "acme",
+	}, map[string]string{
+		"replicas": "int",
+		"ratio":    "float",
+		"enabled":  "bool",
+		"config":   "json",
+		"missing":  "int",
+		"broken":   "int",
+	})
+
+	if got, ok := vars["replicas"].(int64); !ok || got != 3 {
+		t.Errorf("replicas = %#v, want int64(3)", vars["replicas"])
+	}
+	if got, ok := vars["ratio"].(float64); !ok || got != 0.5 {
+		t.Errorf("ratio = %#v, want float64(0.5)", vars["ratio"])
+	}
+	if got, ok := vars["enabled"].(bool); !ok || !got {
+		t.Errorf("enabled = %#v, want true", vars["enabled"])
+	}
+	config, ok := vars["config"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("config = %#v, want map", vars["config"])
+	}
+	limits := config["limits"].(map[string]interface{})
+	if limits["cpu"] != int64(2) || limits["memory"] != 1.5 {
+		t.Errorf("limits = %#v, want cpu int64(2) and memory float64(1.5)", limits)
+	}
+	if vars["missing"] != nil {
+		t.Errorf("missing = %#v, want nil", vars["missing"])
+	}
+	if vars["broken"] != "three" {
+		t.Errorf("broken = %#v, want unparsed string", vars["broken"])
+	}
+	if vars["name"] != "acme" {
+		t.Errorf("name = %#v, want string", vars["name"])
+	}
+
+	// Native types render through the template engine
+	engine := NewEngine()
+	out, err := engine.Render(`{{ add .replicas 1 }}-{{ if .enabled }}on{{ end }}-{{ .config.tier }}-{{ index .config.zones 1 }}`, vars)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if out != "4-on-gold-b" {
+		t.Errorf("Render() = %q, want %q", out, "4-on-gold-b")
+	}
+}
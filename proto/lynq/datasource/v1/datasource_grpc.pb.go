@@ -0,0 +1,141 @@
+// Copyright 2025.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: lynq/datasource/v1/datasource.proto
+
+package datasourcev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Datasource_QueryNodes_FullMethodName = "/lynq.datasource.v1.Datasource/QueryNodes"
+)
+
+// DatasourceClient is the client API for Datasource service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Datasource is served by out-of-process datasource plugins (source type plugin).
+type DatasourceClient interface {
+	// QueryNodes returns one page of node rows.
+	QueryNodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error)
+}
+
+type datasourceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDatasourceClient(cc grpc.ClientConnInterface) DatasourceClient {
+	return &datasourceClient{cc}
+}
+
+func (c *datasourceClient) QueryNodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryNodesResponse)
+	err := c.cc.Invoke(ctx, Datasource_QueryNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DatasourceServer is the server API for Datasource service.
+// All implementations must embed UnimplementedDatasourceServer
+// for forward compatibility.
+//
+// Datasource is served by out-of-process datasource plugins (source type plugin).
+type DatasourceServer interface {
+	// QueryNodes returns one page of node rows.
+	QueryNodes(context.Context, *QueryNodesRequest) (*QueryNodesResponse, error)
+	mustEmbedUnimplementedDatasourceServer()
+}
+
+// UnimplementedDatasourceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDatasourceServer struct{}
+
+func (UnimplementedDatasourceServer) QueryNodes(context.Context, *QueryNodesRequest) (*QueryNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNodes not implemented")
+}
+func (UnimplementedDatasourceServer) mustEmbedUnimplementedDatasourceServer() {}
+func (UnimplementedDatasourceServer) testEmbeddedByValue()                    {}
+
+// UnsafeDatasourceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DatasourceServer will
+// result in compilation errors.
+type UnsafeDatasourceServer interface {
+	mustEmbedUnimplementedDatasourceServer()
+}
+
+func RegisterDatasourceServer(s grpc.ServiceRegistrar, srv DatasourceServer) {
+	// If the following call pancis, it indicates UnimplementedDatasourceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Datasource_ServiceDesc, srv)
+}
+
+func _Datasource_QueryNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatasourceServer).QueryNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Datasource_QueryNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatasourceServer).QueryNodes(ctx, req.(*QueryNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Datasource_ServiceDesc is the grpc.ServiceDesc for Datasource service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Datasource_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "lynq.datasource.v1.Datasource",
+	HandlerType: (*DatasourceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryNodes",
+			Handler:    _Datasource_QueryNodes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lynq/datasource/v1/datasource.proto",
+}